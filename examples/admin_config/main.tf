@@ -0,0 +1,241 @@
+# Configure the OpenWebUI Provider
+terraform {
+  required_providers {
+    openwebui = {
+      source = "coalition-sre/openwebui"
+    }
+  }
+}
+
+provider "openwebui" {
+  # Configuration options - can be provided by environment variables:
+  # endpoint = "http://your-openwebui-instance"  # OPENWEBUI_ENDPOINT
+  # token    = "your-api-token"                  # OPENWEBUI_TOKEN
+}
+
+# Only let admins approve new accounts and notify them about signups in Slack
+variable "slack_webhook_url" {
+  type      = string
+  sensitive = true
+}
+
+resource "openwebui_auth_config" "this" {
+  enable_signup     = false
+  default_user_role = "pending"
+  jwt_expires_in    = "4w"
+  enable_api_key    = true
+  webhook_url       = var.slack_webhook_url
+}
+
+# Authenticate users against Active Directory
+variable "ldap_bind_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "openwebui_ldap_config" "this" {
+  enabled                = true
+  label                  = "Corporate AD"
+  host                   = "ad.example.com"
+  port                   = 636
+  use_tls                = true
+  bind_dn                = "cn=openwebui,ou=service,dc=example,dc=com"
+  search_base            = "ou=users,dc=example,dc=com"
+  search_filters         = "(memberOf=cn=openwebui-users,ou=groups,dc=example,dc=com)"
+  attribute_for_username = "sAMAccountName"
+  attribute_for_mail     = "mail"
+
+  # Keep the bind password out of the state (Terraform 1.11 or later)
+  bind_password_wo         = var.ldap_bind_password
+  bind_password_wo_version = 1
+}
+
+# Sign in through the corporate identity provider
+variable "oidc_client_secret" {
+  type      = string
+  sensitive = true
+}
+
+resource "openwebui_oauth_config" "this" {
+  provider_name           = "Okta"
+  provider_url            = "https://example.okta.com/.well-known/openid-configuration"
+  client_id               = "openwebui"
+  client_secret           = var.oidc_client_secret
+  scopes                  = "openid email profile groups"
+  groups_claim            = "groups"
+  enable_group_management = true
+  merge_accounts_by_email = true
+  enable_signup           = true
+}
+
+# Embed documents with the OpenAI API and rerank hybrid search results
+variable "openai_api_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "openwebui_rag_config" "this" {
+  embedding_engine     = "openai"
+  embedding_model      = "text-embedding-3-small"
+  embedding_openai_url = "https://api.openai.com/v1"
+  embedding_openai_key = var.openai_api_key
+
+  chunk_size    = 1000
+  chunk_overlap = 100
+  top_k         = 5
+
+  hybrid_search       = true
+  reranking_model     = "BAAI/bge-reranker-v2-m3"
+  top_k_reranker      = 3
+  relevance_threshold = 0.2
+}
+
+# Search the web through a self-hosted SearXNG instance, limited to trusted sites
+resource "openwebui_web_search_config" "this" {
+  enabled            = true
+  engine             = "searxng"
+  searxng_query_url  = "http://searxng:8080/search?q=<query>"
+  result_count       = 5
+  domain_filter_list = ["docs.example.com", "wikipedia.org"]
+}
+
+# Generate images with the OpenAI API
+resource "openwebui_image_config" "this" {
+  enabled         = true
+  engine          = "openai"
+  openai_base_url = "https://api.openai.com/v1"
+  openai_api_key  = var.openai_api_key
+  model           = "dall-e-3"
+  size            = "1024x1024"
+}
+
+# Transcribe voice input and read responses aloud with the OpenAI API
+resource "openwebui_audio_config" "this" {
+  stt_engine          = "openai"
+  stt_model           = "whisper-1"
+  stt_openai_base_url = "https://api.openai.com/v1"
+  stt_openai_api_key  = var.openai_api_key
+
+  tts_engine          = "openai"
+  tts_model           = "tts-1"
+  tts_voice           = "alloy"
+  tts_split_on        = "punctuation"
+  tts_openai_base_url = "https://api.openai.com/v1"
+  tts_openai_api_key  = var.openai_api_key
+}
+
+# Run code on the Jupyter server of the sandbox environment
+variable "jupyter_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "openwebui_code_execution_config" "this" {
+  enable_code_execution          = true
+  code_execution_engine          = "jupyter"
+  code_execution_jupyter_url     = "http://jupyter.sandbox:8888"
+  code_execution_jupyter_auth    = "token"
+  code_execution_jupyter_token   = var.jupyter_token
+  code_execution_jupyter_timeout = 60
+
+  enable_code_interpreter          = true
+  code_interpreter_engine          = "jupyter"
+  code_interpreter_jupyter_url     = "http://jupyter.sandbox:8888"
+  code_interpreter_jupyter_auth    = "token"
+  code_interpreter_jupyter_token   = var.jupyter_token
+  code_interpreter_jupyter_timeout = 60
+}
+
+# Run titles, tags and query generation on a small model instead of the model of the chat
+resource "openwebui_task_config" "this" {
+  task_model          = "llama3.2:3b"
+  task_model_external = "gpt-4o-mini"
+
+  enable_title_generation           = true
+  enable_tags_generation            = true
+  enable_autocomplete_generation    = false
+  enable_search_query_generation    = true
+  enable_retrieval_query_generation = true
+}
+
+# Suggest company specific prompts when starting a new chat
+resource "openwebui_interface_config" "this" {
+  default_locale = "en-US"
+
+  prompt_suggestions = [
+    {
+      title    = "Summarize a ticket"
+      subtitle = "paste the ticket text below"
+      content  = "Summarize the following support ticket in three bullet points:\n"
+    },
+    {
+      title   = "Draft a status update"
+      content = "Write a short status update for stakeholders about "
+    },
+  ]
+}
+
+# Announce a maintenance window to all users
+resource "openwebui_banner" "maintenance" {
+  type        = "warning"
+  title       = "Scheduled maintenance"
+  content     = "OpenWebUI will be unavailable on Saturday from 08:00 to 10:00 UTC."
+  dismissible = true
+}
+
+# Compare the curated models blindly in an arena and collect ratings
+resource "openwebui_evaluation_config" "this" {
+  enable_arena_models = true
+  arena_models = [
+    {
+      id          = "arena-model"
+      name        = "Arena Model"
+      description = "Answers with a random model, rate the response to improve the leaderboard"
+      model_ids   = ["gpt-4o", "llama3.1:70b"]
+    },
+  ]
+
+  enable_message_rating    = true
+  enable_community_sharing = false
+}
+
+# Report the collected ratings per model
+data "openwebui_evaluation_feedback" "ratings" {}
+
+output "model_ratings" {
+  value = {
+    for model in data.openwebui_evaluation_feedback.ratings.models :
+    model.model_id => { positive = model.positive, negative = model.negative }
+  }
+}
+
+# Offer a curated set of OpenAI models, prefixed to tell them apart from local models
+resource "openwebui_connection" "openai" {
+  url                = "https://api.openai.com/v1"
+  api_key_wo         = var.openai_api_key
+  api_key_wo_version = 1
+  prefix_id          = "openai"
+  model_ids          = ["gpt-4o", "gpt-4o-mini"]
+}
+
+# Offer the models of a GPU host running Ollama
+resource "openwebui_ollama_connection" "gpu" {
+  url       = "http://gpu-01.internal:11434"
+  prefix_id = "gpu"
+}
+
+# Let users chat and upload files, but keep the workspace to groups that need it
+resource "openwebui_user_permissions" "this" {
+  workspace = {
+    models    = false
+    knowledge = false
+    prompts   = false
+    tools     = false
+  }
+
+  chat = {
+    file_upload = true
+    delete      = false
+    temporary   = true
+  }
+}
@@ -0,0 +1,67 @@
+# Configure the OpenWebUI Provider
+terraform {
+  required_providers {
+    openwebui = {
+      source = "coalition-sre/openwebui"
+    }
+  }
+}
+
+provider "openwebui" {
+  # Configuration options - can be provided by environment variables:
+  # endpoint = "http://your-openwebui-instance"  # OPENWEBUI_ENDPOINT
+  # token    = "your-api-token"                  # OPENWEBUI_TOKEN
+}
+
+variable "teams" {
+  description = "Teams that get their own private channel"
+  type        = set(string)
+  default     = ["platform", "data", "security"]
+}
+
+resource "openwebui_group" "team" {
+  for_each = var.teams
+
+  name        = each.key
+  description = "Members of the ${each.key} team"
+}
+
+# A public channel for everyone
+resource "openwebui_channel" "general" {
+  name        = "general"
+  description = "Company wide announcements and questions"
+}
+
+# One private channel per team, readable and writable by the team's group
+resource "openwebui_channel" "team" {
+  for_each = var.teams
+
+  name        = "team-${each.key}"
+  description = "Private channel of the ${each.key} team"
+  is_private  = true
+
+  access_control = {
+    read = {
+      group_ids = [openwebui_group.team[each.key].id]
+    }
+    write = {
+      group_ids = [openwebui_group.team[each.key].id]
+    }
+  }
+}
+
+# Look up a channel created elsewhere, e.g. to configure a webhook poster
+data "openwebui_channel" "alerts" {
+  name = "alerts"
+}
+
+output "alerts_channel_id" {
+  value = data.openwebui_channel.alerts.id
+}
+
+output "channel_ids" {
+  value = merge(
+    { general = openwebui_channel.general.id },
+    { for team, channel in openwebui_channel.team : team => channel.id },
+  )
+}
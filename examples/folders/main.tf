@@ -0,0 +1,45 @@
+# Configure the OpenWebUI Provider
+terraform {
+  required_providers {
+    openwebui = {
+      source = "coalition-sre/openwebui"
+    }
+  }
+}
+
+provider "openwebui" {
+  # Configuration options - can be provided by environment variables:
+  # endpoint = "http://your-openwebui-instance"  # OPENWEBUI_ENDPOINT
+  # token    = "your-api-token"                  # OPENWEBUI_TOKEN
+}
+
+# Top level folder for a team
+resource "openwebui_folder" "platform" {
+  name = "Platform Team"
+}
+
+# Sub folders with their own chat defaults
+resource "openwebui_folder" "incidents" {
+  name      = "Incidents"
+  parent_id = openwebui_folder.platform.id
+
+  system_prompt = <<-EOT
+    You are assisting with an ongoing production incident. Keep answers short,
+    list concrete next steps and call out anything that needs a rollback.
+  EOT
+
+  model_ids = ["gpt-4o"]
+}
+
+resource "openwebui_folder" "runbooks" {
+  name      = "Runbooks"
+  parent_id = openwebui_folder.platform.id
+}
+
+output "folder_ids" {
+  value = {
+    platform  = openwebui_folder.platform.id
+    incidents = openwebui_folder.incidents.id
+    runbooks  = openwebui_folder.runbooks.id
+  }
+}
@@ -0,0 +1,50 @@
+# Configure the OpenWebUI Provider
+terraform {
+  required_providers {
+    openwebui = {
+      source = "coalition-sre/openwebui"
+    }
+  }
+}
+
+provider "openwebui" {
+  # Configuration options - can be provided by environment variables:
+  # endpoint = "http://your-openwebui-instance"  # OPENWEBUI_ENDPOINT
+  # token    = "your-api-token"                  # OPENWEBUI_TOKEN
+}
+
+# Apply every installed filter to all models
+data "openwebui_functions" "filters" {
+  type = "filter"
+}
+
+resource "openwebui_function_toggle" "filters" {
+  for_each = { for f in data.openwebui_functions.filters.functions : f.id => f }
+
+  function_id = each.key
+  is_active   = true
+  is_global   = true
+}
+
+# Keep an experimental pipe installed, but hide its models
+resource "openwebui_function_toggle" "experimental_pipe" {
+  function_id = "experimental_pipe"
+  is_active   = false
+}
+
+# Configure a pipe that proxies to Anthropic, taking the API key from a variable
+variable "anthropic_api_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "openwebui_function_valves" "anthropic_pipe" {
+  function_id = "anthropic_pipe"
+
+  valves = {
+    MAX_TOKENS = "4096"
+  }
+  sensitive_valves = {
+    ANTHROPIC_API_KEY = var.anthropic_api_key
+  }
+}
@@ -31,6 +31,16 @@ resource "openwebui_group" "administrators" {
       delete      = true # Can delete messages
       edit        = true # Can edit messages
       temporary   = true # Can use temporary chats
+      controls    = true # Can change system prompt and parameters
+    }
+    sharing = {
+      public_models    = true # Can share models with everyone
+      public_knowledge = true # Can share knowledge bases with everyone
+    }
+    features = {
+      web_search       = true # Can use web search
+      image_generation = true # Can generate images
+      code_interpreter = true # Can use the code interpreter
     }
   }
 }
@@ -61,7 +71,9 @@ resource "openwebui_group" "viewers" {
 resource "openwebui_group" "model_developers" {
   name        = "model-developers"
   description = "Team responsible for model development and training"
-  user_ids    = ["dev1", "dev2"]
+
+  # Emails are resolved to user IDs, unknown emails fail the plan
+  member_emails = ["alice@example.com", "bob@example.com"]
 
   permissions = {
     workspace = {
@@ -85,6 +97,9 @@ resource "openwebui_group" "content_managers" {
   description = "Team managing knowledge bases and documentation"
   user_ids    = ["cm1", "cm2"]
 
+  # Members added by OAuth group sync stay in the group without causing a diff
+  ignore_externally_added_members = true
+
   permissions = {
     workspace = {
       models    = false # Cannot manage models
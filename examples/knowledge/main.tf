@@ -56,9 +56,18 @@ resource "openwebui_group" "researchers" {
 
 # Example 1: Technical Documentation Knowledge Base
 resource "openwebui_knowledge" "tech_docs" {
-  name           = "Technical Documentation"
-  description    = "Comprehensive technical documentation for our systems"
-  access_control = "private" # Restricted access
+  name        = "Technical Documentation"
+  description = "Comprehensive technical documentation for our systems"
+  is_private  = true # Restricted access
+
+  access_control = {
+    read = {
+      group_ids = [openwebui_group.developers.id]
+    }
+    write = {
+      group_ids = [openwebui_group.developers.id]
+    }
+  }
 
   data = {
     category    = "technical"
@@ -76,9 +85,18 @@ resource "openwebui_knowledge" "tech_docs" {
 
 # Example 2: Research Papers Knowledge Base
 resource "openwebui_knowledge" "research_papers" {
-  name           = "Research Papers"
-  description    = "Collection of research papers and findings"
-  access_control = "private"
+  name        = "Research Papers"
+  description = "Collection of research papers and findings"
+  is_private  = true
+
+  access_control = {
+    read = {
+      group_ids = [openwebui_group.researchers.id, openwebui_group.developers.id]
+    }
+    write = {
+      group_ids = [openwebui_group.researchers.id]
+    }
+  }
 
   data = {
     category        = "research"
@@ -93,9 +111,8 @@ resource "openwebui_knowledge" "research_papers" {
 
 # Example 3: Public Documentation
 resource "openwebui_knowledge" "public_docs" {
-  name           = "Public API Documentation"
-  description    = "Public-facing API documentation and guides"
-  access_control = "public"
+  name        = "Public API Documentation"
+  description = "Public-facing API documentation and guides"
 
   data = {
     category = "api-documentation"
@@ -109,9 +126,9 @@ resource "openwebui_knowledge" "public_docs" {
 
 # Example 4: Training Materials
 resource "openwebui_knowledge" "training" {
-  name           = "Employee Training Materials"
-  description    = "Internal training documentation and resources"
-  access_control = "private"
+  name        = "Employee Training Materials"
+  description = "Internal training documentation and resources"
+  is_private  = true
 
   data = {
     category   = "training"
@@ -124,6 +141,23 @@ resource "openwebui_knowledge" "training" {
   }
 }
 
+# Upload a local document into the technical documentation knowledge base
+resource "openwebui_knowledge_file" "onboarding" {
+  knowledge_id = openwebui_knowledge.tech_docs.id
+  source       = "${path.module}/docs/onboarding.md"
+
+  # Make sure the document is indexed before anything depends on it
+  wait_for_processing = true
+  processing_timeout  = 600
+}
+
+# Keep the training knowledge base in sync with every Markdown file in docs/
+resource "openwebui_knowledge_sync" "training" {
+  knowledge_id = openwebui_knowledge.training.id
+  directory    = "${path.module}/docs"
+  pattern      = "*.md"
+}
+
 # Create a specialized model for documentation
 resource "openwebui_model" "documentation_assistant" {
   name          = "Documentation Assistant"
@@ -170,8 +204,10 @@ output "knowledge_base_ids" {
 
 output "knowledge_metadata" {
   value = {
-    tech_docs_data = data.openwebui_knowledge.tech_docs.data
-    research_data  = data.openwebui_knowledge.research.data
+    tech_docs_data  = data.openwebui_knowledge.tech_docs.data
+    research_data   = data.openwebui_knowledge.research.data
+    tech_docs_files = [for f in data.openwebui_knowledge.tech_docs.files : f.name]
+    research_groups = data.openwebui_knowledge.research.access_control.read.group_ids
   }
 }
 
@@ -192,3 +228,12 @@ output "documentation_setup" {
     ]
   }
 }
+
+# List every knowledge base whose name starts with "Research"
+data "openwebui_knowledge_bases" "research" {
+  name_prefix = "Research"
+}
+
+output "research_knowledge_bases" {
+  value = { for kb in data.openwebui_knowledge_bases.research.knowledge_bases : kb.name => kb.file_count }
+}
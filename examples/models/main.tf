@@ -35,7 +35,7 @@ resource "openwebui_model" "devops_gpt4" {
 
   params {
     # Specialized system prompt for DevOps tasks
-    system          = <<-EOT
+    system           = <<-EOT
       You are a DevOps expert specialized in:
       - Infrastructure as Code
       - CI/CD pipelines
@@ -44,23 +44,46 @@ resource "openwebui_model" "devops_gpt4" {
       - Monitoring and logging
       Provide practical, security-conscious advice.
     EOT
-    stream_response = true
-    temperature     = 0.7  # Balanced creativity and consistency
-    top_p           = 0.9  # Nucleus sampling for diverse responses
-    max_tokens      = 2000 # Longer responses for detailed explanations
-    seed            = 42   # Fixed seed for reproducibility
+    stream_response  = true
+    temperature      = 0.7      # Balanced creativity and consistency
+    top_p            = 0.9      # Nucleus sampling for diverse responses
+    max_tokens       = 2000     # Longer responses for detailed explanations
+    seed             = 42       # Fixed seed for reproducibility
+    function_calling = "native" # Use the model's native tool calling
+    stop             = ["</answer>", "User:"]
+    logit_bias = {
+      "50256" = -100 # Never emit the end of text token
+    }
   }
 
   meta {
     description       = "A customized GPT-4 model optimized for DevOps and infrastructure tasks"
     profile_image_url = "/static/devops-icon.png"
+    tool_ids          = ["web_search"] # IDs of workspace tools, e.g. openwebui_tool.weather.id
 
     capabilities {
-      vision    = false # No vision capabilities needed
-      usage     = true  # Track usage statistics
-      citations = true  # Enable source citations
+      vision           = false # No vision capabilities needed
+      usage            = true  # Track usage statistics
+      citations        = true  # Enable source citations
+      web_search       = true  # Look up current documentation
+      code_interpreter = true  # Run snippets to verify answers
+    }
+
+    default_features = {
+      web_search = true # Pre-enable web search in new chats
     }
 
+    suggestion_prompts = [
+      {
+        title   = ["Review a Terraform plan", "and flag risky changes"]
+        content = "Review this Terraform plan and flag risky changes:"
+      },
+      {
+        title   = ["Write a GitHub Actions workflow", "for a Go project"]
+        content = "Write a GitHub Actions workflow that builds and tests a Go project."
+      },
+    ]
+
     tags {
       name = "infrastructure"
     }
@@ -104,7 +127,8 @@ resource "openwebui_model" "research_assistant" {
   }
 
   meta {
-    description = "Academic research assistant model with emphasis on scientific rigor"
+    description   = "Academic research assistant model with emphasis on scientific rigor"
+    knowledge_ids = [openwebui_knowledge.model_docs.id]
 
     capabilities {
       vision    = true # Enable vision for analyzing graphs and figures
@@ -150,7 +174,8 @@ resource "openwebui_model" "code_reviewer" {
     temperature       = 0.2 # Low temperature for consistent code analysis
     top_p             = 0.8
     max_tokens        = 1500
-    frequency_penalty = 1 # Reduce repetitive suggestions
+    frequency_penalty = 0.3 # Reduce repetitive suggestions
+    presence_penalty  = 0.2 # Encourage covering new issues
   }
 
   meta {
@@ -180,6 +205,33 @@ resource "openwebui_model" "code_reviewer" {
   }
 }
 
+# Local Ollama model tuned for a small GPU
+resource "openwebui_model" "local_llama" {
+  base_model_id = "llama3.1:8b"
+  name          = "Local Llama"
+  is_active     = true
+
+  params = {
+    temperature    = 0.6
+    num_ctx        = 8192
+    num_gpu        = 20 # Offload 20 layers, run the rest on the CPU
+    num_thread     = 8
+    mirostat       = 2
+    mirostat_eta   = 0.1
+    mirostat_tau   = 5.0
+    repeat_penalty = 1.1
+    use_mlock      = true
+  }
+
+  # Uploaded as a data URI, changes to the file are detected by its hash
+  profile_image_path = "${path.module}/images/llama.png"
+
+  # Meta fields the provider does not model yet
+  meta_json = jsonencode({
+    hidden = false
+  })
+}
+
 # Create a knowledge base for model documentation
 resource "openwebui_knowledge" "model_docs" {
   name        = "Model Documentation"
@@ -196,6 +248,12 @@ data "openwebui_model" "devops" {
   name = openwebui_model.devops_gpt4.name
 }
 
+# List all active models tagged "devops"
+data "openwebui_models" "devops" {
+  tag       = "devops"
+  is_active = true
+}
+
 # Outputs for verification and reference
 output "model_ids" {
   value = {
@@ -205,6 +263,36 @@ output "model_ids" {
   }
 }
 
+# Start new chats with the DevOps model, compared side by side with the research assistant
+resource "openwebui_default_models" "this" {
+  model_ids = [
+    openwebui_model.devops_gpt4.id,
+    openwebui_model.research_assistant.id,
+  ]
+}
+
+# List the curated models first in the model selector of all users
+resource "openwebui_model_order" "this" {
+  model_ids = [
+    openwebui_model.devops_gpt4.id,
+    openwebui_model.research_assistant.id,
+    openwebui_model.code_reviewer.id,
+  ]
+}
+
+# Base models served by the configured Ollama connections
+data "openwebui_base_models" "ollama" {
+  owned_by = "ollama"
+}
+
+output "ollama_base_model_ids" {
+  value = data.openwebui_base_models.ollama.ids
+}
+
+output "devops_model_ids" {
+  value = data.openwebui_models.devops.models[*].id
+}
+
 output "model_capabilities" {
   value = {
     devops_capabilities   = data.openwebui_model.devops.meta.capabilities
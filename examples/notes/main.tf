@@ -0,0 +1,43 @@
+# Configure the OpenWebUI Provider
+terraform {
+  required_providers {
+    openwebui = {
+      source = "coalition-sre/openwebui"
+    }
+  }
+}
+
+provider "openwebui" {
+  # Configuration options - can be provided by environment variables:
+  # endpoint = "http://your-openwebui-instance"  # OPENWEBUI_ENDPOINT
+  # token    = "your-api-token"                  # OPENWEBUI_TOKEN
+}
+
+# Share the onboarding guide with every user
+resource "openwebui_note" "onboarding" {
+  title   = "Onboarding"
+  content = file("${path.module}/notes/onboarding.md")
+}
+
+# Keep the incident runbook private to the on-call engineers
+resource "openwebui_group" "on_call" {
+  name        = "On-call"
+  description = "Engineers in the on-call rotation"
+}
+
+resource "openwebui_note" "incident_runbook" {
+  title = "Incident runbook"
+  content = templatefile("${path.module}/notes/incident_runbook.md.tftpl", {
+    status_page = "https://status.example.com"
+  })
+
+  is_private = true
+  access_control = {
+    read = {
+      group_ids = [openwebui_group.on_call.id]
+    }
+    write = {
+      group_ids = [openwebui_group.on_call.id]
+    }
+  }
+}
@@ -0,0 +1,33 @@
+# Configure the OpenWebUI Provider
+terraform {
+  required_providers {
+    openwebui = {
+      source = "coalition-sre/openwebui"
+    }
+  }
+}
+
+provider "openwebui" {
+  # Configuration options - can be provided by environment variables:
+  # endpoint = "http://your-openwebui-instance"  # OPENWEBUI_ENDPOINT
+  # token    = "your-api-token"                  # OPENWEBUI_TOKEN
+}
+
+# Limit the requests of users to all models served by OpenWebUI
+resource "openwebui_pipeline" "rate_limit" {
+  pipelines_url = "http://pipelines:9099"
+  filename      = "rate_limit_filter_pipeline.py"
+  content       = file("${path.module}/pipelines/rate_limit_filter_pipeline.py")
+
+  valves = {
+    requests_per_minute = "10"
+    pipelines           = jsonencode(["*"])
+  }
+}
+
+output "rate_limit_pipeline" {
+  value = {
+    id   = openwebui_pipeline.rate_limit.id
+    type = openwebui_pipeline.rate_limit.type
+  }
+}
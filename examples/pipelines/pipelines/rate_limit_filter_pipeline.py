@@ -0,0 +1,35 @@
+"""
+title: Rate Limit Filter Pipeline
+description: Limits the number of requests a user can send per minute
+requirements: pydantic
+"""
+
+import time
+from typing import List, Optional
+
+from pydantic import BaseModel
+
+
+class Pipeline:
+    class Valves(BaseModel):
+        pipelines: List[str] = []
+        priority: int = 0
+        requests_per_minute: Optional[int] = None
+
+    def __init__(self):
+        self.type = "filter"
+        self.name = "Rate Limit Filter"
+        self.valves = self.Valves(pipelines=["*"])
+        self.user_requests = {}
+
+    async def inlet(self, body: dict, user: Optional[dict] = None) -> dict:
+        if not user or user.get("role") == "admin" or not self.valves.requests_per_minute:
+            return body
+
+        now = time.time()
+        recent = [t for t in self.user_requests.get(user["id"], []) if now - t < 60]
+        if len(recent) >= self.valves.requests_per_minute:
+            raise Exception("Rate limit exceeded, please try again in a minute.")
+
+        self.user_requests[user["id"]] = recent + [now]
+        return body
@@ -14,9 +14,41 @@ terraform {
 # 2. Using environment variables:
 #    - OPENWEBUI_ENDPOINT
 #    - OPENWEBUI_TOKEN
+# 3. Reading the token from a file with token_file, e.g. a mounted secret
 provider "openwebui" {
   endpoint = "http://localhost:8080" # Optional: can be set via OPENWEBUI_ENDPOINT
   # token = "your-api-token"         # Optional: can be set via OPENWEBUI_TOKEN
+  # token_file = "/run/secrets/openwebui-token"
+
+  # Alternatively sign in with a user's credentials to obtain a session token
+  # email    = "ci@example.com"
+  # password = var.openwebui_password
+
+  # Retry rate limited (429) and gateway (502/503/504) responses
+  max_retries = 5
+  retry_wait  = "2s"
+
+  # Allow large knowledge base uploads to finish
+  timeout = "10m"
+
+  # Trust the internal CA that signed the OpenWebUI certificate
+  # ca_cert_file = "/etc/ssl/internal-ca.pem"
+
+  # Present a client certificate to an mTLS ingress
+  # client_cert_pem = file("client.pem")
+  # client_key_pem  = file("client-key.pem")
+
+  # Route requests through a proxy instead of the one from HTTPS_PROXY
+  # proxy_url = "http://proxy.internal:3128"
+
+  # Gzip large request bodies, when the proxy in front of OpenWebUI decompresses them
+  # compress_requests = true
+
+  # Service token headers for Cloudflare Access
+  # headers = {
+  #   "CF-Access-Client-Id"     = var.cf_access_client_id
+  #   "CF-Access-Client-Secret" = var.cf_access_client_secret
+  # }
 }
 
 # Create a group for managing access
@@ -97,6 +129,26 @@ data "openwebui_model" "base_model" {
   name = "GPT-4" # Look up existing model
 }
 
+# Guard features of newer OpenWebUI releases
+data "openwebui_version" "current" {}
+
+check "openwebui_version" {
+  assert {
+    condition     = data.openwebui_version.current.major > 0 || data.openwebui_version.current.minor >= 5
+    error_message = "OpenWebUI ${data.openwebui_version.current.version} is older than 0.5.0, native function calling is not available."
+  }
+}
+
+# Make sure changes are made by the admin service account
+data "openwebui_current_user" "me" {}
+
+check "openwebui_current_user" {
+  assert {
+    condition     = data.openwebui_current_user.me.role == "admin" && data.openwebui_current_user.me.email == "terraform@example.com"
+    error_message = "The provider token belongs to ${data.openwebui_current_user.me.email} (${data.openwebui_current_user.me.role}), expected the terraform@example.com admin service account."
+  }
+}
+
 # Output some useful information
 output "data_science_group_id" {
   value = openwebui_group.data_science.id
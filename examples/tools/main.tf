@@ -0,0 +1,90 @@
+# Configure the OpenWebUI Provider
+terraform {
+  required_providers {
+    openwebui = {
+      source = "coalition-sre/openwebui"
+    }
+  }
+}
+
+provider "openwebui" {
+  # Configuration options - can be provided by environment variables:
+  # endpoint = "http://your-openwebui-instance"  # OPENWEBUI_ENDPOINT
+  # token    = "your-api-token"                  # OPENWEBUI_TOKEN
+}
+
+resource "openwebui_group" "engineering" {
+  name        = "engineering"
+  description = "Engineering team"
+}
+
+# Example 1: Public tool loaded from a file in the repository
+resource "openwebui_tool" "weather" {
+  id          = "weather"
+  name        = "Weather"
+  description = "Looks up the current weather for a city"
+  content     = file("${path.module}/tools/weather.py")
+}
+
+# Example 2: Private tool shared with a group
+resource "openwebui_tool" "calculator" {
+  id          = "calculator"
+  name        = "Calculator"
+  description = "Evaluates arithmetic expressions"
+  is_private  = true
+
+  content = <<-EOT
+    class Tools:
+        def __init__(self):
+            pass
+
+        def calculate(self, expression: str) -> str:
+            """
+            Evaluate an arithmetic expression.
+            :param expression: The expression to evaluate, e.g. "2 * (3 + 4)".
+            """
+            allowed = set("0123456789+-*/(). ")
+            if not set(expression) <= allowed:
+                return "Invalid expression"
+            return str(eval(expression))
+  EOT
+
+  access_control = {
+    read = {
+      group_ids = [openwebui_group.engineering.id]
+    }
+  }
+}
+
+# Report the weather in US units. Secrets such as API keys go into
+# sensitive_valves instead, so they are hidden in the plan output
+resource "openwebui_tool_valves" "weather" {
+  tool_id = openwebui_tool.weather.id
+
+  valves = {
+    UNITS   = "u"
+    TIMEOUT = "5"
+  }
+}
+
+# Upload a lookup table for tools to read from the file store, without
+# attaching it to a knowledge base
+resource "openwebui_file" "office_locations" {
+  filename = "office_locations.csv"
+  content  = <<-EOT
+    city,timezone
+    Berlin,Europe/Berlin
+    San Francisco,America/Los_Angeles
+  EOT
+}
+
+output "tool_specs" {
+  value = {
+    weather    = jsondecode(openwebui_tool.weather.specs)
+    calculator = jsondecode(openwebui_tool.calculator.specs)
+  }
+}
+
+output "office_locations_file_id" {
+  value = openwebui_file.office_locations.id
+}
@@ -0,0 +1,29 @@
+"""
+title: Weather
+description: Looks up the current weather for a city
+"""
+
+import requests
+from pydantic import BaseModel, Field
+
+
+class Tools:
+    class Valves(BaseModel):
+        UNITS: str = Field(default="m", description="Units of the report, m for metric or u for USCS")
+        TIMEOUT: int = Field(default=10, description="Timeout of the weather lookup in seconds")
+
+    def __init__(self):
+        self.valves = self.Valves()
+
+    def get_weather(self, city: str) -> str:
+        """
+        Get the current weather for a city.
+        :param city: The name of the city.
+        """
+        response = requests.get(
+            f"https://wttr.in/{city}",
+            params={"format": "3", self.valves.UNITS: ""},
+            timeout=self.valves.TIMEOUT,
+        )
+        response.raise_for_status()
+        return response.text
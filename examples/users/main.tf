@@ -5,6 +5,9 @@ terraform {
     openwebui = {
       source = "coalition-sre/openwebui"
     }
+    time = {
+      source = "hashicorp/time"
+    }
   }
 }
 
@@ -22,6 +25,71 @@ data "openwebui_user" "example" {
   # Note: Only use one of: email, name, or id
 }
 
+# Example: Provision a service account
+variable "service_account_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "openwebui_user" "ci_bot" {
+  name     = "CI Bot"
+  email    = "ci-bot@example.com"
+  password = var.service_account_password
+  role     = "user"
+
+  # With Terraform 1.11 or later, keep the password out of the state instead
+  # password_wo         = var.service_account_password
+  # password_wo_version = 1
+}
+
+# Example: Generate an API key for the service account, rotated every 90 days
+resource "time_rotating" "ci_bot_key" {
+  rotation_days = 90
+}
+
+resource "openwebui_user_api_key" "ci_bot" {
+  email    = openwebui_user.ci_bot.email
+  password = var.service_account_password
+
+  rotate_when = {
+    rotation = time_rotating.ci_bot_key.id
+  }
+}
+
+# Example: Seed the memories of the service account, using its own API key
+provider "openwebui" {
+  alias    = "ci_bot"
+  endpoint = "https://chat.example.com"
+  token    = openwebui_user_api_key.ci_bot.key
+}
+
+resource "openwebui_memory" "ci_bot_release_days" {
+  provider = openwebui.ci_bot
+  content  = "Releases are deployed to production on Tuesdays and Thursdays."
+}
+
+resource "openwebui_user_settings" "ci_bot" {
+  provider          = openwebui.ci_bot
+  default_model_ids = ["gpt-4o-mini"]
+  system_prompt     = "Answer concisely and include the commands you ran."
+
+  ui_json = jsonencode({
+    chatBubble = false
+  })
+}
+
+# Example: List the chats of the service account for archiving
+data "openwebui_chats" "ci_bot" {
+  user_id = openwebui_user.ci_bot.id
+}
+
+output "service_account_chats" {
+  value = {
+    for chat in data.openwebui_chats.ci_bot.chats :
+    chat.id => chat.title if !chat.archived
+  }
+}
+
 # Output user information
 output "user_info" {
   value = {
@@ -35,3 +103,12 @@ output "user_info" {
     updated_at        = data.openwebui_user.example.updated_at
   }
 }
+
+output "service_account_id" {
+  value = openwebui_user.ci_bot.id
+}
+
+output "service_account_api_key" {
+  value     = openwebui_user_api_key.ci_bot.key
+  sensitive = true
+}
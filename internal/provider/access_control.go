@@ -0,0 +1,199 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// accessGroupAttrTypes are the attribute types of the read and write blocks of access_control.
+var accessGroupAttrTypes = map[string]attr.Type{
+	"group_ids": types.ListType{ElemType: types.StringType},
+	"user_ids":  types.ListType{ElemType: types.StringType},
+}
+
+// accessControlAttrTypes are the attribute types of access_control.
+var accessControlAttrTypes = map[string]attr.Type{
+	"read":  types.ObjectType{AttrTypes: accessGroupAttrTypes},
+	"write": types.ObjectType{AttrTypes: accessGroupAttrTypes},
+}
+
+// accessGroup holds the users and groups granted a single permission.
+type accessGroup struct {
+	GroupIDs []string
+	UserIDs  []string
+}
+
+// accessControlResourceAttribute returns the access_control attribute for resources
+// that pair it with an is_private flag, e.g. "tool" or "knowledge base".
+func accessControlResourceAttribute(subject string) schema.SingleNestedAttribute {
+	accessGroupAttributes := func(permission string) map[string]schema.Attribute {
+		return map[string]schema.Attribute{
+			"group_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("Group IDs with %s access", permission),
+			},
+			"user_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("User IDs with %s access", permission),
+			},
+		}
+	}
+
+	return schema.SingleNestedAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: fmt.Sprintf("Users and groups allowed to access a private %s. Defaults to no additional access when `is_private` is `true`", subject),
+		PlanModifiers:       []planmodifier.Object{AccessControlDefaultModifier{}},
+		Attributes: map[string]schema.Attribute{
+			"read": schema.SingleNestedAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Read access settings",
+				Attributes:          accessGroupAttributes("read"),
+			},
+			"write": schema.SingleNestedAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Write access settings",
+				Attributes:          accessGroupAttributes("write"),
+			},
+		},
+	}
+}
+
+// accessControlDataSourceAttribute returns the read-only access_control attribute for data sources.
+func accessControlDataSourceAttribute(subject string) datasourceschema.SingleNestedAttribute {
+	accessGroupAttributes := func(permission string) map[string]datasourceschema.Attribute {
+		return map[string]datasourceschema.Attribute{
+			"group_ids": datasourceschema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("Group IDs with %s access", permission),
+			},
+			"user_ids": datasourceschema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("User IDs with %s access", permission),
+			},
+		}
+	}
+
+	return datasourceschema.SingleNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: fmt.Sprintf("Users and groups allowed to access the %s. Null when it is public", subject),
+		Attributes: map[string]datasourceschema.Attribute{
+			"read": datasourceschema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Read access settings",
+				Attributes:          accessGroupAttributes("read"),
+			},
+			"write": datasourceschema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Write access settings",
+				Attributes:          accessGroupAttributes("write"),
+			},
+		},
+	}
+}
+
+// accessControlFromObject converts an access_control value into its read and write permissions.
+func accessControlFromObject(ctx context.Context, obj types.Object) (accessGroup, accessGroup, diag.Diagnostics) {
+	var accessControl struct {
+		Read  types.Object `tfsdk:"read"`
+		Write types.Object `tfsdk:"write"`
+	}
+	diags := obj.As(ctx, &accessControl, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return accessGroup{}, accessGroup{}, diags
+	}
+
+	read, d := accessGroupFromObject(ctx, accessControl.Read)
+	diags.Append(d...)
+	write, d := accessGroupFromObject(ctx, accessControl.Write)
+	diags.Append(d...)
+
+	return read, write, diags
+}
+
+// accessControlObject converts read and write permissions into an access_control value.
+func accessControlObject(ctx context.Context, read, write accessGroup) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	readObj, d := accessGroupObject(ctx, read)
+	diags.Append(d...)
+	writeObj, d := accessGroupObject(ctx, write)
+	diags.Append(d...)
+	if diags.HasError() {
+		return types.ObjectNull(accessControlAttrTypes), diags
+	}
+
+	obj, d := types.ObjectValue(accessControlAttrTypes, map[string]attr.Value{
+		"read":  readObj,
+		"write": writeObj,
+	})
+	diags.Append(d...)
+
+	return obj, diags
+}
+
+// accessGroupFromObject converts a read or write block into its IDs. Unset lists grant no access.
+func accessGroupFromObject(ctx context.Context, obj types.Object) (accessGroup, diag.Diagnostics) {
+	group := accessGroup{GroupIDs: []string{}, UserIDs: []string{}}
+	if obj.IsNull() || obj.IsUnknown() {
+		return group, nil
+	}
+
+	var lists struct {
+		GroupIDs types.List `tfsdk:"group_ids"`
+		UserIDs  types.List `tfsdk:"user_ids"`
+	}
+	diags := obj.As(ctx, &lists, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return group, diags
+	}
+
+	if !lists.GroupIDs.IsNull() && !lists.GroupIDs.IsUnknown() {
+		diags.Append(lists.GroupIDs.ElementsAs(ctx, &group.GroupIDs, false)...)
+	}
+	if !lists.UserIDs.IsNull() && !lists.UserIDs.IsUnknown() {
+		diags.Append(lists.UserIDs.ElementsAs(ctx, &group.UserIDs, false)...)
+	}
+
+	return group, diags
+}
+
+// accessGroupObject converts the IDs of a read or write permission into its block value.
+func accessGroupObject(ctx context.Context, group accessGroup) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	groupIDs, d := types.ListValueFrom(ctx, types.StringType, append([]string{}, group.GroupIDs...))
+	diags.Append(d...)
+	userIDs, d := types.ListValueFrom(ctx, types.StringType, append([]string{}, group.UserIDs...))
+	diags.Append(d...)
+	if diags.HasError() {
+		return types.ObjectNull(accessGroupAttrTypes), diags
+	}
+
+	obj, d := types.ObjectValue(accessGroupAttrTypes, map[string]attr.Value{
+		"group_ids": groupIDs,
+		"user_ids":  userIDs,
+	})
+	diags.Append(d...)
+
+	return obj, diags
+}
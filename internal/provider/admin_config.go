@@ -0,0 +1,68 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The admin configuration resources manage instance wide settings that always
+// exist. Creating such a resource applies the configured settings, destroying
+// it only removes it from the state. Settings that are not configured keep
+// their current value, which is read into the state.
+
+// knownBool returns a pointer to the value of v, or nil when v is null or
+// unknown, so that settings which are not configured are left unchanged.
+func knownBool(v types.Bool) *bool {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	return v.ValueBoolPointer()
+}
+
+// knownString is the types.String counterpart of knownBool.
+func knownString(v types.String) *string {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	return v.ValueStringPointer()
+}
+
+// knownInt64 is the types.Int64 counterpart of knownBool.
+func knownInt64(v types.Int64) *int64 {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	return v.ValueInt64Pointer()
+}
+
+// knownFloat64 is the types.Float64 counterpart of knownBool.
+func knownFloat64(v types.Float64) *float64 {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	return v.ValueFloat64Pointer()
+}
+
+// knownStringList is the counterpart of knownBool for lists of strings.
+func knownStringList(ctx context.Context, v types.List) (*[]string, diag.Diagnostics) {
+	if v.IsNull() || v.IsUnknown() {
+		return nil, nil
+	}
+	list := []string{}
+	diags := v.ElementsAs(ctx, &list, false)
+	return &list, diags
+}
+
+// stringListPointerValue converts a setting holding a list of strings into a
+// types.List, which is null when the setting is not set.
+func stringListPointerValue(ctx context.Context, list *[]string) (types.List, diag.Diagnostics) {
+	if list == nil {
+		return types.ListNull(types.StringType), nil
+	}
+	return types.ListValueFrom(ctx, types.StringType, *list)
+}
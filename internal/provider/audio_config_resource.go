@@ -0,0 +1,277 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/configs"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &AudioConfigResource{}
+var _ resource.ResourceWithImportState = &AudioConfigResource{}
+
+func NewAudioConfigResource() resource.Resource {
+	return &AudioConfigResource{}
+}
+
+// AudioConfigResource defines the resource implementation.
+type AudioConfigResource struct {
+	client *configs.Client
+}
+
+// AudioConfigResourceModel describes the resource data model.
+type AudioConfigResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	STTEngine         types.String `tfsdk:"stt_engine"`
+	STTModel          types.String `tfsdk:"stt_model"`
+	STTWhisperModel   types.String `tfsdk:"stt_whisper_model"`
+	STTOpenAIBaseURL  types.String `tfsdk:"stt_openai_base_url"`
+	STTOpenAIAPIKey   types.String `tfsdk:"stt_openai_api_key"`
+	STTDeepgramAPIKey types.String `tfsdk:"stt_deepgram_api_key"`
+	TTSEngine         types.String `tfsdk:"tts_engine"`
+	TTSModel          types.String `tfsdk:"tts_model"`
+	TTSVoice          types.String `tfsdk:"tts_voice"`
+	TTSSplitOn        types.String `tfsdk:"tts_split_on"`
+	TTSOpenAIBaseURL  types.String `tfsdk:"tts_openai_base_url"`
+	TTSOpenAIAPIKey   types.String `tfsdk:"tts_openai_api_key"`
+	TTSAPIKey         types.String `tfsdk:"tts_api_key"`
+}
+
+func (r *AudioConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audio_config"
+}
+
+func (r *AudioConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages speech-to-text and text-to-speech for voice input and read aloud. Requires an admin token. " +
+			"Settings that are not configured keep their current value. Destroying the resource leaves the settings unchanged",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Always `audio`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"stt_engine": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Speech-to-text engine (`openai`, `deepgram` or `web` for the browser). An empty string uses the Whisper model built into OpenWebUI",
+				Validators: []validator.String{
+					stringvalidator.OneOf("", "openai", "deepgram", "web"),
+				},
+			},
+			"stt_model": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Speech-to-text model of the `openai` or `deepgram` engine, e.g. `whisper-1`",
+			},
+			"stt_whisper_model": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whisper model used by the built-in engine, e.g. `base`",
+			},
+			"stt_openai_base_url": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Base URL of the OpenAI compatible API used by the `openai` speech-to-text engine",
+			},
+			"stt_openai_api_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "API key used by the `openai` speech-to-text engine",
+			},
+			"stt_deepgram_api_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "API key used by the `deepgram` speech-to-text engine",
+			},
+			"tts_engine": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Text-to-speech engine (`openai`, `elevenlabs`, `azure` or `transformers`). An empty string uses the speech synthesis of the browser",
+				Validators: []validator.String{
+					stringvalidator.OneOf("", "openai", "elevenlabs", "azure", "transformers"),
+				},
+			},
+			"tts_model": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Text-to-speech model, e.g. `tts-1`",
+			},
+			"tts_voice": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Default voice, e.g. `alloy`. Users can pick another voice in their settings",
+			},
+			"tts_split_on": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "How responses are split before they are read aloud (`punctuation`, `paragraphs` or `none`)",
+				Validators: []validator.String{
+					stringvalidator.OneOf("punctuation", "paragraphs", "none"),
+				},
+			},
+			"tts_openai_base_url": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Base URL of the OpenAI compatible API used by the `openai` text-to-speech engine",
+			},
+			"tts_openai_api_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "API key used by the `openai` text-to-speech engine",
+			},
+			"tts_api_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "API key used by the `elevenlabs` and `azure` text-to-speech engines",
+			},
+		},
+	}
+}
+
+func (r *AudioConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Configs
+}
+
+func (r *AudioConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AudioConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *AudioConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AudioConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.GetAudioConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read audio config, got error: %s", err))
+		return
+	}
+
+	setAudioConfigState(config, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AudioConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AudioConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *AudioConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The settings always exist, so there is nothing to delete
+}
+
+func (r *AudioConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply sends the configured settings and stores the resulting configuration in state.
+func (r *AudioConfigResource) apply(ctx context.Context, data *AudioConfigResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	config, err := r.client.UpdateAudioConfig(ctx, &configs.AudioConfig{
+		STT: &configs.STTConfig{
+			Engine:         knownString(data.STTEngine),
+			Model:          knownString(data.STTModel),
+			WhisperModel:   knownString(data.STTWhisperModel),
+			OpenAIBaseURL:  knownString(data.STTOpenAIBaseURL),
+			OpenAIAPIKey:   knownString(data.STTOpenAIAPIKey),
+			DeepgramAPIKey: knownString(data.STTDeepgramAPIKey),
+		},
+		TTS: &configs.TTSConfig{
+			Engine:        knownString(data.TTSEngine),
+			Model:         knownString(data.TTSModel),
+			Voice:         knownString(data.TTSVoice),
+			SplitOn:       knownString(data.TTSSplitOn),
+			OpenAIBaseURL: knownString(data.TTSOpenAIBaseURL),
+			OpenAIAPIKey:  knownString(data.TTSOpenAIAPIKey),
+			APIKey:        knownString(data.TTSAPIKey),
+		},
+	})
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update audio config, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("audio")
+	setAudioConfigState(config, data)
+
+	// Save data into Terraform state
+	diags.Append(state.Set(ctx, data)...)
+}
+
+// setAudioConfigState copies the server representation of the settings into
+// the resource data. API keys are only read when they are configured.
+func setAudioConfigState(config *configs.AudioConfig, data *AudioConfigResourceModel) {
+	data.STTEngine = types.StringPointerValue(config.STT.Engine)
+	data.STTModel = types.StringPointerValue(config.STT.Model)
+	data.STTWhisperModel = types.StringPointerValue(config.STT.WhisperModel)
+	data.STTOpenAIBaseURL = types.StringPointerValue(config.STT.OpenAIBaseURL)
+	if !data.STTOpenAIAPIKey.IsNull() {
+		data.STTOpenAIAPIKey = types.StringPointerValue(config.STT.OpenAIAPIKey)
+	}
+	if !data.STTDeepgramAPIKey.IsNull() {
+		data.STTDeepgramAPIKey = types.StringPointerValue(config.STT.DeepgramAPIKey)
+	}
+	data.TTSEngine = types.StringPointerValue(config.TTS.Engine)
+	data.TTSModel = types.StringPointerValue(config.TTS.Model)
+	data.TTSVoice = types.StringPointerValue(config.TTS.Voice)
+	data.TTSSplitOn = types.StringPointerValue(config.TTS.SplitOn)
+	data.TTSOpenAIBaseURL = types.StringPointerValue(config.TTS.OpenAIBaseURL)
+	if !data.TTSOpenAIAPIKey.IsNull() {
+		data.TTSOpenAIAPIKey = types.StringPointerValue(config.TTS.OpenAIAPIKey)
+	}
+	if !data.TTSAPIKey.IsNull() {
+		data.TTSAPIKey = types.StringPointerValue(config.TTS.APIKey)
+	}
+}
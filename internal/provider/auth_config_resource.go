@@ -0,0 +1,223 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/configs"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &AuthConfigResource{}
+var _ resource.ResourceWithImportState = &AuthConfigResource{}
+
+func NewAuthConfigResource() resource.Resource {
+	return &AuthConfigResource{}
+}
+
+// AuthConfigResource defines the resource implementation.
+type AuthConfigResource struct {
+	client *configs.Client
+}
+
+// AuthConfigResourceModel describes the resource data model.
+type AuthConfigResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	EnableSignup     types.Bool   `tfsdk:"enable_signup"`
+	DefaultUserRole  types.String `tfsdk:"default_user_role"`
+	JWTExpiresIn     types.String `tfsdk:"jwt_expires_in"`
+	EnableAPIKey     types.Bool   `tfsdk:"enable_api_key"`
+	ShowAdminDetails types.Bool   `tfsdk:"show_admin_details"`
+	WebhookURL       types.String `tfsdk:"webhook_url"`
+}
+
+func (r *AuthConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth_config"
+}
+
+func (r *AuthConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the signup and authentication settings of the instance. Requires an admin token. " +
+			"Settings that are not configured keep their current value. Destroying the resource leaves the settings unchanged",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Always `auth`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enable_signup": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether new users can sign up",
+			},
+			"default_user_role": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Role of newly signed up users (`pending`, `user` or `admin`)",
+				Validators: []validator.String{
+					stringvalidator.OneOf("pending", "user", "admin"),
+				},
+			},
+			"jwt_expires_in": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Lifetime of session tokens, e.g. `4w` or `12h`. `-1` disables expiration",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^(-1|(\d+(\.\d+)?(ms|s|m|h|d|w))+)$`), "must be -1 or a duration such as 4w or 1h30m"),
+				},
+			},
+			"enable_api_key": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether users can generate API keys",
+			},
+			"show_admin_details": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether the admin contact details are shown to pending users",
+			},
+			"webhook_url": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "URL notified about new signups, e.g. a Slack, Discord or Microsoft Teams incoming webhook. An empty string disables the notifications",
+			},
+		},
+	}
+}
+
+func (r *AuthConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Configs
+}
+
+func (r *AuthConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AuthConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *AuthConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AuthConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.GetAuthConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read auth config, got error: %s", err))
+		return
+	}
+
+	webhookURL, err := r.client.GetWebhookURL(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read webhook URL, got error: %s", err))
+		return
+	}
+
+	setAuthConfigState(config, webhookURL, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AuthConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AuthConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *AuthConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The settings always exist, so there is nothing to delete
+}
+
+func (r *AuthConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply sends the configured settings and stores the resulting configuration in state.
+func (r *AuthConfigResource) apply(ctx context.Context, data *AuthConfigResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	config, err := r.client.UpdateAuthConfig(ctx, &configs.AuthConfig{
+		ShowAdminDetails: knownBool(data.ShowAdminDetails),
+		EnableSignup:     knownBool(data.EnableSignup),
+		EnableAPIKey:     knownBool(data.EnableAPIKey),
+		DefaultUserRole:  knownString(data.DefaultUserRole),
+		JWTExpiresIn:     knownString(data.JWTExpiresIn),
+	})
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update auth config, got error: %s", err))
+		return
+	}
+
+	var webhookURL string
+	if url := knownString(data.WebhookURL); url != nil {
+		webhookURL, err = r.client.SetWebhookURL(ctx, *url)
+	} else {
+		webhookURL, err = r.client.GetWebhookURL(ctx)
+	}
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update webhook URL, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("auth")
+	setAuthConfigState(config, webhookURL, data)
+
+	// Save data into Terraform state
+	diags.Append(state.Set(ctx, data)...)
+}
+
+// setAuthConfigState copies the server representation of the settings into the resource data.
+func setAuthConfigState(config *configs.AuthConfig, webhookURL string, data *AuthConfigResourceModel) {
+	data.EnableSignup = types.BoolPointerValue(config.EnableSignup)
+	data.DefaultUserRole = types.StringPointerValue(config.DefaultUserRole)
+	data.JWTExpiresIn = types.StringPointerValue(config.JWTExpiresIn)
+	data.EnableAPIKey = types.BoolPointerValue(config.EnableAPIKey)
+	data.ShowAdminDetails = types.BoolPointerValue(config.ShowAdminDetails)
+	data.WebhookURL = types.StringValue(webhookURL)
+}
@@ -0,0 +1,235 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/configs"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &BannerResource{}
+var _ resource.ResourceWithImportState = &BannerResource{}
+
+func NewBannerResource() resource.Resource {
+	return &BannerResource{}
+}
+
+// BannerResource defines the resource implementation.
+type BannerResource struct {
+	client *configs.Client
+}
+
+// BannerResourceModel describes the resource data model.
+type BannerResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Type        types.String `tfsdk:"type"`
+	Title       types.String `tfsdk:"title"`
+	Content     types.String `tfsdk:"content"`
+	Dismissible types.Bool   `tfsdk:"dismissible"`
+	Timestamp   types.Int64  `tfsdk:"timestamp"`
+}
+
+func (r *BannerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_banner"
+}
+
+func (r *BannerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Banner resource for OpenWebUI. Banners are announcements shown to all users above the chat, e.g. maintenance notices. " +
+			"Requires an admin token. Banners added in the admin panel are left untouched",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the banner",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Level of the banner, which sets its color (`info`, `success`, `warning` or `error`)",
+				Validators: []validator.String{
+					stringvalidator.OneOf("info", "success", "warning", "error"),
+				},
+			},
+			"title": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Title of the banner",
+			},
+			"content": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Text of the banner. Supports Markdown",
+			},
+			"dismissible": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether users can close the banner. Defaults to `true`",
+			},
+			"timestamp": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Unix timestamp of the banner. Users who dismissed the banner see it again when it changes. Defaults to the time of creation",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *BannerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Configs
+}
+
+func (r *BannerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BannerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to generate banner ID, got error: %s", err))
+		return
+	}
+	data.ID = types.StringValue(id)
+	if data.Timestamp.IsUnknown() {
+		data.Timestamp = types.Int64Value(time.Now().Unix())
+	}
+
+	banner, err := r.client.SetBanner(ctx, bannerFromModel(&data))
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to create banner, got error: %s", err))
+		return
+	}
+
+	setBannerState(banner, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BannerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BannerResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	banner, err := r.client.GetBanner(ctx, data.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, apierror.ErrNotFound) {
+			// Deleted outside of Terraform, plan to create it again
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read banner, got error: %s", err))
+		return
+	}
+
+	setBannerState(banner, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BannerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BannerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	banner, err := r.client.SetBanner(ctx, bannerFromModel(&data))
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update banner, got error: %s", err))
+		return
+	}
+
+	setBannerState(banner, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BannerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BannerResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteBanner(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete banner, got error: %s", err))
+		return
+	}
+}
+
+func (r *BannerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// bannerFromModel converts the resource data into the API representation.
+func bannerFromModel(data *BannerResourceModel) *configs.Banner {
+	return &configs.Banner{
+		ID:          data.ID.ValueString(),
+		Type:        data.Type.ValueString(),
+		Title:       data.Title.ValueStringPointer(),
+		Content:     data.Content.ValueString(),
+		Dismissible: data.Dismissible.ValueBool(),
+		Timestamp:   data.Timestamp.ValueInt64(),
+	}
+}
+
+// setBannerState copies the server representation of the banner into the resource data.
+func setBannerState(banner *configs.Banner, data *BannerResourceModel) {
+	data.ID = types.StringValue(banner.ID)
+	data.Type = types.StringValue(banner.Type)
+	data.Title = types.StringPointerValue(banner.Title)
+	data.Content = types.StringValue(banner.Content)
+	data.Dismissible = types.BoolValue(banner.Dismissible)
+	data.Timestamp = types.Int64Value(banner.Timestamp)
+}
@@ -0,0 +1,137 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/models"
+)
+
+var (
+	_ datasource.DataSource = &BaseModelsDataSource{}
+)
+
+type BaseModelsDataSourceModel struct {
+	OwnedBy types.String          `tfsdk:"owned_by"`
+	IDs     types.List            `tfsdk:"ids"`
+	Models  []BaseModelEntryModel `tfsdk:"models"`
+}
+
+// BaseModelEntryModel describes a single base model in the list.
+type BaseModelEntryModel struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	OwnedBy types.String `tfsdk:"owned_by"`
+}
+
+func NewBaseModelsDataSource() datasource.DataSource {
+	return &BaseModelsDataSource{}
+}
+
+type BaseModelsDataSource struct {
+	client *models.Client
+}
+
+func (d *BaseModelsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_base_models"
+}
+
+func (d *BaseModelsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the base models exposed by the configured OpenAI and Ollama connections, e.g. `gpt-4o` or `llama3.1:70b`.",
+		Attributes: map[string]schema.Attribute{
+			"owned_by": schema.StringAttribute{
+				Description: "Only return models owned by this connection type, e.g. `openai` or `ollama`.",
+				Optional:    true,
+			},
+			"ids": schema.ListAttribute{
+				Description: "IDs of the matching base models.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"models": schema.ListNestedAttribute{
+				Description: "The matching base models.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the base model.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the base model.",
+							Computed:    true,
+						},
+						"owned_by": schema.StringAttribute{
+							Description: "The connection type providing the model.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BaseModelsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = clients.Models
+}
+
+func (d *BaseModelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config BaseModelsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	baseModels, err := d.client.GetBaseModels(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to list base models, got error: %s", err))
+		return
+	}
+
+	ids := []string{}
+	config.Models = make([]BaseModelEntryModel, 0, len(baseModels))
+	for _, model := range baseModels {
+		if !config.OwnedBy.IsNull() && model.OwnedBy != config.OwnedBy.ValueString() {
+			continue
+		}
+
+		ids = append(ids, model.ID)
+		config.Models = append(config.Models, BaseModelEntryModel{
+			ID:      types.StringValue(model.ID),
+			Name:    types.StringValue(model.Name),
+			OwnedBy: types.StringValue(model.OwnedBy),
+		})
+	}
+
+	config.IDs, diags = types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,294 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/channels"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ChannelResource{}
+var _ resource.ResourceWithImportState = &ChannelResource{}
+
+func NewChannelResource() resource.Resource {
+	return &ChannelResource{}
+}
+
+// ChannelResource defines the resource implementation.
+type ChannelResource struct {
+	client *channels.Client
+}
+
+// ChannelResourceModel describes the resource data model.
+type ChannelResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	IsPrivate     types.Bool   `tfsdk:"is_private"`
+	AccessControl types.Object `tfsdk:"access_control"`
+	UserID        types.String `tfsdk:"user_id"`
+	CreatedAt     types.Int64  `tfsdk:"created_at"`
+	UpdatedAt     types.Int64  `tfsdk:"updated_at"`
+}
+
+func (r *ChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel"
+}
+
+func (r *ChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Channel resource for OpenWebUI. Channels are shared chat rooms for users and models. Requires an admin token and channels to be enabled on the server",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Channel identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the channel. OpenWebUI stores channel names in lowercase, so uppercase letters are rejected",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^A-Z]+$`), "must not contain uppercase letters"),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Description of the channel",
+			},
+			"is_private": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the channel is private. `access_control` must be unset when this is set to `false`",
+			},
+			"access_control": accessControlResourceAttribute("channel"),
+			"user_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the user who created the channel",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp when the channel was created",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp when the channel was last updated",
+			},
+		},
+	}
+}
+
+func (r *ChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Channels
+}
+
+func (r *ChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ChannelResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	form, diags := channelForm(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := r.client.Create(ctx, form)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to create channel, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setChannelState(ctx, channel, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ChannelResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := r.client.Get(ctx, data.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, apierror.ErrNotFound) {
+			// Deleted outside of Terraform, plan to create it again
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read channel, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setChannelState(ctx, channel, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ChannelResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	form, diags := channelForm(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := r.client.Update(ctx, data.ID.ValueString(), form)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update channel, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setChannelState(ctx, channel, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ChannelResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Delete(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete channel, got error: %s", err))
+		return
+	}
+}
+
+func (r *ChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// channelForm converts the planned resource data into the API payload.
+func channelForm(ctx context.Context, data *ChannelResourceModel) (*channels.ChannelForm, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	form := &channels.ChannelForm{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+	}
+
+	// Public channels have no access control at all
+	if !data.IsPrivate.ValueBool() || data.AccessControl.IsNull() || data.AccessControl.IsUnknown() {
+		return form, diags
+	}
+
+	read, write, d := accessControlFromObject(ctx, data.AccessControl)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	form.AccessControl = &channels.AccessControl{
+		Read:  channels.AccessGroup{GroupIDs: read.GroupIDs, UserIDs: read.UserIDs},
+		Write: channels.AccessGroup{GroupIDs: write.GroupIDs, UserIDs: write.UserIDs},
+	}
+
+	return form, diags
+}
+
+// setChannelState copies the server representation of a channel into the resource data.
+func setChannelState(ctx context.Context, channel *channels.Channel, data *ChannelResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(channel.ID)
+	data.Name = types.StringValue(channel.Name)
+	data.UserID = types.StringValue(channel.UserID)
+	data.CreatedAt = types.Int64Value(channel.CreatedAt)
+	data.UpdatedAt = types.Int64Value(channel.UpdatedAt)
+
+	if channel.Description != nil && (*channel.Description != "" || !data.Description.IsNull()) {
+		data.Description = types.StringValue(*channel.Description)
+	} else {
+		data.Description = types.StringNull()
+	}
+
+	if channel.AccessControl == nil {
+		data.IsPrivate = types.BoolValue(false)
+		data.AccessControl = types.ObjectNull(accessControlAttrTypes)
+		return diags
+	}
+
+	ac := channel.AccessControl
+	data.IsPrivate = types.BoolValue(true)
+	accessControl, d := accessControlObject(ctx,
+		accessGroup{GroupIDs: ac.Read.GroupIDs, UserIDs: ac.Read.UserIDs},
+		accessGroup{GroupIDs: ac.Write.GroupIDs, UserIDs: ac.Write.UserIDs},
+	)
+	diags.Append(d...)
+	data.AccessControl = accessControl
+
+	return diags
+}
@@ -0,0 +1,167 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/channels"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ChannelDataSource{}
+
+func NewChannelDataSource() datasource.DataSource {
+	return &ChannelDataSource{}
+}
+
+// ChannelDataSource defines the data source implementation.
+type ChannelDataSource struct {
+	client *channels.Client
+}
+
+// ChannelDataSourceModel describes the data source data model.
+type ChannelDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	IsPrivate     types.Bool   `tfsdk:"is_private"`
+	AccessControl types.Object `tfsdk:"access_control"`
+	UserID        types.String `tfsdk:"user_id"`
+	CreatedAt     types.Int64  `tfsdk:"created_at"`
+	UpdatedAt     types.Int64  `tfsdk:"updated_at"`
+}
+
+func (d *ChannelDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel"
+}
+
+func (d *ChannelDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a channel by its name, e.g. to pass its ID to automation posting into the channel. Only channels visible to the provider token are found",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Channel identifier",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the channel to look up. Matched case-insensitively, since OpenWebUI stores channel names in lowercase",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Description of the channel",
+			},
+			"is_private": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the channel is private",
+			},
+			"access_control": accessControlDataSourceAttribute("channel"),
+			"user_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the user who created the channel",
+			},
+			"created_at": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp when the channel was created",
+			},
+			"updated_at": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp when the channel was last updated",
+			},
+		},
+	}
+}
+
+func (d *ChannelDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = clients.Channels
+}
+
+func (d *ChannelDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ChannelDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get channels from API
+	channelList, err := d.client.List(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read channels, got error: %s", err))
+		return
+	}
+
+	// Find the channels with matching name
+	var matches []channels.Channel
+	for _, channel := range channelList {
+		if strings.EqualFold(channel.Name, data.Name.ValueString()) {
+			matches = append(matches, channel)
+		}
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError(
+			"Channel Not Found",
+			fmt.Sprintf("No channel found with name: %s", data.Name.ValueString()),
+		)
+		return
+	}
+	if len(matches) > 1 {
+		resp.Diagnostics.AddError(
+			"Multiple Channels Found",
+			fmt.Sprintf("Found %d channels with name %s. Rename them so that the name is unique.", len(matches), data.Name.ValueString()),
+		)
+		return
+	}
+
+	channel := matches[0]
+	data.ID = types.StringValue(channel.ID)
+	data.Name = types.StringValue(channel.Name)
+	data.Description = types.StringPointerValue(channel.Description)
+	data.UserID = types.StringValue(channel.UserID)
+	data.CreatedAt = types.Int64Value(channel.CreatedAt)
+	data.UpdatedAt = types.Int64Value(channel.UpdatedAt)
+
+	if channel.AccessControl == nil {
+		data.IsPrivate = types.BoolValue(false)
+		data.AccessControl = types.ObjectNull(accessControlAttrTypes)
+	} else {
+		ac := channel.AccessControl
+		accessControl, diags := accessControlObject(ctx,
+			accessGroup{GroupIDs: ac.Read.GroupIDs, UserIDs: ac.Read.UserIDs},
+			accessGroup{GroupIDs: ac.Write.GroupIDs, UserIDs: ac.Write.UserIDs},
+		)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.IsPrivate = types.BoolValue(true)
+		data.AccessControl = accessControl
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,141 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/chats"
+)
+
+var (
+	_ datasource.DataSource = &ChatsDataSource{}
+)
+
+type ChatsDataSourceModel struct {
+	UserID types.String     `tfsdk:"user_id"`
+	Chats  []ChatEntryModel `tfsdk:"chats"`
+}
+
+// ChatEntryModel describes a single chat in the list.
+type ChatEntryModel struct {
+	ID        types.String `tfsdk:"id"`
+	Title     types.String `tfsdk:"title"`
+	Archived  types.Bool   `tfsdk:"archived"`
+	CreatedAt types.Int64  `tfsdk:"created_at"`
+	UpdatedAt types.Int64  `tfsdk:"updated_at"`
+}
+
+func NewChatsDataSource() datasource.DataSource {
+	return &ChatsDataSource{}
+}
+
+type ChatsDataSource struct {
+	client *chats.Client
+}
+
+func (d *ChatsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_chats"
+}
+
+func (d *ChatsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the chats of a user, including archived chats, e.g. for compliance archiving. Messages are not returned. " +
+			"Requires an admin token and the admin export of OpenWebUI (`ENABLE_ADMIN_EXPORT`), which is enabled by default.",
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the user whose chats to list.",
+				Required:    true,
+			},
+			"chats": schema.ListNestedAttribute{
+				Description: "The chats of the user, oldest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the chat.",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "The title of the chat.",
+							Computed:    true,
+						},
+						"archived": schema.BoolAttribute{
+							Description: "Whether the user archived the chat.",
+							Computed:    true,
+						},
+						"created_at": schema.Int64Attribute{
+							Description: "Timestamp when the chat was created.",
+							Computed:    true,
+						},
+						"updated_at": schema.Int64Attribute{
+							Description: "Timestamp when the chat was last updated.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ChatsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = clients.Chats
+}
+
+func (d *ChatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ChatsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userChats, err := d.client.ListByUser(ctx, state.UserID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to list chats of user %s, got error: %s", state.UserID.ValueString(), err))
+		return
+	}
+
+	// Keep the order stable, so new chats don't shift existing entries
+	sort.Slice(userChats, func(i, j int) bool {
+		if userChats[i].CreatedAt != userChats[j].CreatedAt {
+			return userChats[i].CreatedAt < userChats[j].CreatedAt
+		}
+		return userChats[i].ID < userChats[j].ID
+	})
+
+	state.Chats = make([]ChatEntryModel, 0, len(userChats))
+	for _, chat := range userChats {
+		state.Chats = append(state.Chats, ChatEntryModel{
+			ID:        types.StringValue(chat.ID),
+			Title:     types.StringValue(chat.Title),
+			Archived:  types.BoolValue(chat.Archived),
+			CreatedAt: types.Int64Value(chat.CreatedAt),
+			UpdatedAt: types.Int64Value(chat.UpdatedAt),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -1,166 +0,0 @@
-// Copyright (c) HashiCorp, Inc.
-
-package groups
-
-import (
-	"bytes"
-	"encoding/json"
-	"fmt"
-	"net/http"
-)
-
-type Client struct {
-	BaseURL    string
-	Token      string
-	HTTPClient *http.Client
-}
-
-func NewClient(baseURL string, token string) *Client {
-	return &Client{
-		BaseURL:    baseURL,
-		Token:      token,
-		HTTPClient: &http.Client{},
-	}
-}
-
-func (c *Client) Create(group *Group) (*Group, error) {
-	body, err := json.Marshal(group)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/v1/groups/create", c.BaseURL), bytes.NewBuffer(body))
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("accept", "application/json")
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status code: %d", resp.StatusCode)
-	}
-
-	var createdGroup Group
-	if err := json.NewDecoder(resp.Body).Decode(&createdGroup); err != nil {
-		return nil, err
-	}
-
-	return &createdGroup, nil
-}
-
-func (c *Client) Get(id string) (*Group, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/groups/id/%s", c.BaseURL, id), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
-	req.Header.Set("accept", "application/json")
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status code: %d", resp.StatusCode)
-	}
-
-	var group Group
-	if err := json.NewDecoder(resp.Body).Decode(&group); err != nil {
-		return nil, err
-	}
-
-	return &group, nil
-}
-
-func (c *Client) Update(id string, group *Group) (*Group, error) {
-	body, err := json.Marshal(group)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/v1/groups/id/%s/update", c.BaseURL, id), bytes.NewBuffer(body))
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("accept", "application/json")
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status code: %d", resp.StatusCode)
-	}
-
-	var updatedGroup Group
-	if err := json.NewDecoder(resp.Body).Decode(&updatedGroup); err != nil {
-		return nil, err
-	}
-
-	return &updatedGroup, nil
-}
-
-func (c *Client) Delete(id string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/v1/groups/id/%s/delete", c.BaseURL, id), nil)
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
-	req.Header.Set("accept", "application/json")
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("API request failed with status code: %d", resp.StatusCode)
-	}
-
-	return nil
-}
-
-func (c *Client) List() ([]Group, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/groups/", c.BaseURL), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
-	req.Header.Set("accept", "application/json")
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status code: %d", resp.StatusCode)
-	}
-
-	var groups []Group
-	if err := json.NewDecoder(resp.Body).Decode(&groups); err != nil {
-		return nil, err
-	}
-
-	return groups, nil
-}
@@ -0,0 +1,26 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"errors"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+)
+
+// clientErrorSummary returns the diagnostic summary for an error returned by a
+// client package, naming the error class when the API reported one.
+func clientErrorSummary(err error) string {
+	switch {
+	case errors.Is(err, apierror.ErrUnauthorized):
+		return "Authentication Failed"
+	case errors.Is(err, apierror.ErrForbidden):
+		return "Permission Denied"
+	case errors.Is(err, apierror.ErrConflict):
+		return "Conflict"
+	case errors.Is(err, apierror.ErrNotFound):
+		return "Not Found"
+	}
+	return "Client Error"
+}
@@ -0,0 +1,297 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/configs"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &CodeExecutionConfigResource{}
+var _ resource.ResourceWithImportState = &CodeExecutionConfigResource{}
+
+func NewCodeExecutionConfigResource() resource.Resource {
+	return &CodeExecutionConfigResource{}
+}
+
+// CodeExecutionConfigResource defines the resource implementation.
+type CodeExecutionConfigResource struct {
+	client *configs.Client
+}
+
+// CodeExecutionConfigResourceModel describes the resource data model.
+type CodeExecutionConfigResourceModel struct {
+	ID                             types.String `tfsdk:"id"`
+	EnableCodeExecution            types.Bool   `tfsdk:"enable_code_execution"`
+	CodeExecutionEngine            types.String `tfsdk:"code_execution_engine"`
+	CodeExecutionJupyterURL        types.String `tfsdk:"code_execution_jupyter_url"`
+	CodeExecutionJupyterAuth       types.String `tfsdk:"code_execution_jupyter_auth"`
+	CodeExecutionJupyterToken      types.String `tfsdk:"code_execution_jupyter_token"`
+	CodeExecutionJupyterPassword   types.String `tfsdk:"code_execution_jupyter_password"`
+	CodeExecutionJupyterTimeout    types.Int64  `tfsdk:"code_execution_jupyter_timeout"`
+	EnableCodeInterpreter          types.Bool   `tfsdk:"enable_code_interpreter"`
+	CodeInterpreterEngine          types.String `tfsdk:"code_interpreter_engine"`
+	CodeInterpreterPromptTemplate  types.String `tfsdk:"code_interpreter_prompt_template"`
+	CodeInterpreterJupyterURL      types.String `tfsdk:"code_interpreter_jupyter_url"`
+	CodeInterpreterJupyterAuth     types.String `tfsdk:"code_interpreter_jupyter_auth"`
+	CodeInterpreterJupyterToken    types.String `tfsdk:"code_interpreter_jupyter_token"`
+	CodeInterpreterJupyterPassword types.String `tfsdk:"code_interpreter_jupyter_password"`
+	CodeInterpreterJupyterTimeout  types.Int64  `tfsdk:"code_interpreter_jupyter_timeout"`
+}
+
+func (r *CodeExecutionConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_code_execution_config"
+}
+
+func (r *CodeExecutionConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	engine := []validator.String{
+		stringvalidator.OneOf("pyodide", "jupyter"),
+	}
+	auth := []validator.String{
+		stringvalidator.OneOf("", "token", "password"),
+	}
+	timeout := []validator.Int64{
+		int64validator.AtLeast(1),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages running code blocks of responses and the code interpreter, either in the browser with Pyodide or on a Jupyter server. " +
+			"Requires an admin token. Settings that are not configured keep their current value. Destroying the resource leaves the settings unchanged",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Always `code_execution`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enable_code_execution": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether users can run code blocks of responses",
+			},
+			"code_execution_engine": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Engine running code blocks (`pyodide` or `jupyter`)",
+				Validators:          engine,
+			},
+			"code_execution_jupyter_url": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "URL of the Jupyter server running code blocks",
+			},
+			"code_execution_jupyter_auth": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Authentication against the Jupyter server running code blocks (`token`, `password` or an empty string for none)",
+				Validators:          auth,
+			},
+			"code_execution_jupyter_token": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Token of the Jupyter server running code blocks",
+			},
+			"code_execution_jupyter_password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Password of the Jupyter server running code blocks",
+			},
+			"code_execution_jupyter_timeout": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Seconds after which a code block running on Jupyter is aborted",
+				Validators:          timeout,
+			},
+			"enable_code_interpreter": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether models can write and run code while answering",
+			},
+			"code_interpreter_engine": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Engine of the code interpreter (`pyodide` or `jupyter`)",
+				Validators:          engine,
+			},
+			"code_interpreter_prompt_template": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Prompt instructing the model how to use the code interpreter. An empty string uses the built-in prompt",
+			},
+			"code_interpreter_jupyter_url": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "URL of the Jupyter server of the code interpreter",
+			},
+			"code_interpreter_jupyter_auth": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Authentication against the Jupyter server of the code interpreter (`token`, `password` or an empty string for none)",
+				Validators:          auth,
+			},
+			"code_interpreter_jupyter_token": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Token of the Jupyter server of the code interpreter",
+			},
+			"code_interpreter_jupyter_password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Password of the Jupyter server of the code interpreter",
+			},
+			"code_interpreter_jupyter_timeout": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Seconds after which code of the code interpreter running on Jupyter is aborted",
+				Validators:          timeout,
+			},
+		},
+	}
+}
+
+func (r *CodeExecutionConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Configs
+}
+
+func (r *CodeExecutionConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CodeExecutionConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *CodeExecutionConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CodeExecutionConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.GetCodeExecutionConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read code execution config, got error: %s", err))
+		return
+	}
+
+	setCodeExecutionConfigState(config, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CodeExecutionConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CodeExecutionConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *CodeExecutionConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The settings always exist, so there is nothing to delete
+}
+
+func (r *CodeExecutionConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply sends the configured settings and stores the resulting configuration in state.
+func (r *CodeExecutionConfigResource) apply(ctx context.Context, data *CodeExecutionConfigResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	config, err := r.client.UpdateCodeExecutionConfig(ctx, &configs.CodeExecutionConfig{
+		EnableCodeExecution:            knownBool(data.EnableCodeExecution),
+		CodeExecutionEngine:            knownString(data.CodeExecutionEngine),
+		CodeExecutionJupyterURL:        knownString(data.CodeExecutionJupyterURL),
+		CodeExecutionJupyterAuth:       knownString(data.CodeExecutionJupyterAuth),
+		CodeExecutionJupyterToken:      knownString(data.CodeExecutionJupyterToken),
+		CodeExecutionJupyterPassword:   knownString(data.CodeExecutionJupyterPassword),
+		CodeExecutionJupyterTimeout:    knownInt64(data.CodeExecutionJupyterTimeout),
+		EnableCodeInterpreter:          knownBool(data.EnableCodeInterpreter),
+		CodeInterpreterEngine:          knownString(data.CodeInterpreterEngine),
+		CodeInterpreterPromptTemplate:  knownString(data.CodeInterpreterPromptTemplate),
+		CodeInterpreterJupyterURL:      knownString(data.CodeInterpreterJupyterURL),
+		CodeInterpreterJupyterAuth:     knownString(data.CodeInterpreterJupyterAuth),
+		CodeInterpreterJupyterToken:    knownString(data.CodeInterpreterJupyterToken),
+		CodeInterpreterJupyterPassword: knownString(data.CodeInterpreterJupyterPassword),
+		CodeInterpreterJupyterTimeout:  knownInt64(data.CodeInterpreterJupyterTimeout),
+	})
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update code execution config, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("code_execution")
+	setCodeExecutionConfigState(config, data)
+
+	// Save data into Terraform state
+	diags.Append(state.Set(ctx, data)...)
+}
+
+// setCodeExecutionConfigState copies the server representation of the settings
+// into the resource data. Jupyter credentials are only read when they are configured.
+func setCodeExecutionConfigState(config *configs.CodeExecutionConfig, data *CodeExecutionConfigResourceModel) {
+	data.EnableCodeExecution = types.BoolPointerValue(config.EnableCodeExecution)
+	data.CodeExecutionEngine = types.StringPointerValue(config.CodeExecutionEngine)
+	data.CodeExecutionJupyterURL = types.StringPointerValue(config.CodeExecutionJupyterURL)
+	data.CodeExecutionJupyterAuth = types.StringPointerValue(config.CodeExecutionJupyterAuth)
+	if !data.CodeExecutionJupyterToken.IsNull() {
+		data.CodeExecutionJupyterToken = types.StringPointerValue(config.CodeExecutionJupyterToken)
+	}
+	if !data.CodeExecutionJupyterPassword.IsNull() {
+		data.CodeExecutionJupyterPassword = types.StringPointerValue(config.CodeExecutionJupyterPassword)
+	}
+	data.CodeExecutionJupyterTimeout = types.Int64PointerValue(config.CodeExecutionJupyterTimeout)
+	data.EnableCodeInterpreter = types.BoolPointerValue(config.EnableCodeInterpreter)
+	data.CodeInterpreterEngine = types.StringPointerValue(config.CodeInterpreterEngine)
+	data.CodeInterpreterPromptTemplate = types.StringPointerValue(config.CodeInterpreterPromptTemplate)
+	data.CodeInterpreterJupyterURL = types.StringPointerValue(config.CodeInterpreterJupyterURL)
+	data.CodeInterpreterJupyterAuth = types.StringPointerValue(config.CodeInterpreterJupyterAuth)
+	if !data.CodeInterpreterJupyterToken.IsNull() {
+		data.CodeInterpreterJupyterToken = types.StringPointerValue(config.CodeInterpreterJupyterToken)
+	}
+	if !data.CodeInterpreterJupyterPassword.IsNull() {
+		data.CodeInterpreterJupyterPassword = types.StringPointerValue(config.CodeInterpreterJupyterPassword)
+	}
+	data.CodeInterpreterJupyterTimeout = types.Int64PointerValue(config.CodeInterpreterJupyterTimeout)
+}
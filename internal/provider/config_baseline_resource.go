@@ -0,0 +1,301 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/configs"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ConfigBaselineResource{}
+var _ resource.ResourceWithModifyPlan = &ConfigBaselineResource{}
+
+func NewConfigBaselineResource() resource.Resource {
+	return &ConfigBaselineResource{}
+}
+
+// ConfigBaselineResource defines the resource implementation.
+type ConfigBaselineResource struct {
+	client *configs.Client
+}
+
+// ConfigBaselineResourceModel describes the resource data model.
+type ConfigBaselineResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Sections        types.Set    `tfsdk:"sections"`
+	Triggers        types.Map    `tfsdk:"triggers"`
+	FailOnDrift     types.Bool   `tfsdk:"fail_on_drift"`
+	Baseline        types.Map    `tfsdk:"baseline"`
+	DriftedSections types.List   `tfsdk:"drifted_sections"`
+	HasDrift        types.Bool   `tfsdk:"has_drift"`
+}
+
+func (r *ConfigBaselineResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_baseline"
+}
+
+func (r *ConfigBaselineResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Captures a baseline of selected admin configuration sections and reports drift from it on every refresh. " +
+			"The resource never writes configuration back to the server. Changing any argument re-captures the baseline from the current configuration. Requires an admin token.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Baseline identifier (same as `name`)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the baseline, used in drift diagnostics. Changing it re-creates the baseline",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sections": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Top-level sections of the exported admin configuration to track (e.g. `auth`, `rag`, `ui`)",
+				Required:            true,
+			},
+			"triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Arbitrary values that re-capture the baseline when changed, e.g. to accept an approved configuration change",
+			},
+			"fail_on_drift": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether detected drift fails the plan instead of being reported as a warning. Changing any argument, e.g. `triggers`, still re-captures the baseline",
+			},
+			"baseline": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Captured configuration, as a JSON document per section",
+			},
+			"drifted_sections": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Sections whose current configuration differs from the baseline",
+			},
+			"has_drift": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether any tracked section differs from the baseline",
+			},
+		},
+	}
+}
+
+func (r *ConfigBaselineResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Configs
+}
+
+func (r *ConfigBaselineResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ConfigBaselineResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.capture(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.Name
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConfigBaselineResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ConfigBaselineResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var baseline map[string]string
+	resp.Diagnostics.Append(data.Baseline.ElementsAs(ctx, &baseline, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.snapshot(ctx, data.Sections)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to export admin configuration, got error: %s", err))
+		return
+	}
+
+	drifted := make([]string, 0)
+	for section, value := range current {
+		if baseline[section] != value {
+			drifted = append(drifted, section)
+		}
+	}
+	sort.Strings(drifted)
+
+	driftedSections, diags := types.ListValueFrom(ctx, types.StringType, drifted)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.DriftedSections = driftedSections
+	data.HasDrift = types.BoolValue(len(drifted) > 0)
+
+	// With fail_on_drift, the plan fails instead, so that a trigger change accepting the drift can still be planned
+	if len(drifted) > 0 && !data.FailOnDrift.ValueBool() {
+		resp.Diagnostics.AddWarning(configDriftSummary, configDriftDetail(data.Name.ValueString(), drifted))
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// ModifyPlan fails the plan on drift when fail_on_drift is set, unless the
+// plan re-captures the baseline anyway.
+func (r *ConfigBaselineResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ConfigBaselineResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.FailOnDrift.ValueBool() || !state.HasDrift.ValueBool() {
+		return
+	}
+	if !plan.Name.Equal(state.Name) || !plan.Sections.Equal(state.Sections) ||
+		!plan.Triggers.Equal(state.Triggers) || !plan.FailOnDrift.Equal(state.FailOnDrift) {
+		return
+	}
+
+	var drifted []string
+	resp.Diagnostics.Append(state.DriftedSections.ElementsAs(ctx, &drifted, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.AddError(configDriftSummary, configDriftDetail(state.Name.ValueString(), drifted))
+}
+
+func (r *ConfigBaselineResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ConfigBaselineResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.capture(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.Name
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConfigBaselineResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The baseline only exists in Terraform state, so there is nothing to delete on the server.
+}
+
+// capture records the current configuration of the tracked sections as the new baseline.
+func (r *ConfigBaselineResource) capture(ctx context.Context, data *ConfigBaselineResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	current, err := r.snapshot(ctx, data.Sections)
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to export admin configuration, got error: %s", err))
+		return diags
+	}
+
+	baseline, d := types.MapValueFrom(ctx, types.StringType, current)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Baseline = baseline
+	data.DriftedSections, d = types.ListValueFrom(ctx, types.StringType, []string{})
+	diags.Append(d...)
+	data.HasDrift = types.BoolValue(false)
+
+	return diags
+}
+
+// snapshot exports the admin configuration and returns the JSON encoding of each requested section.
+func (r *ConfigBaselineResource) snapshot(ctx context.Context, sections types.Set) (map[string]string, error) {
+	var names []string
+	if diags := sections.ElementsAs(ctx, &names, false); diags.HasError() {
+		return nil, fmt.Errorf("invalid sections: %v", diags)
+	}
+
+	config, err := r.client.Export(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string, len(names))
+	for _, name := range names {
+		// encoding/json sorts map keys, so equal configurations always encode identically
+		encoded, err := json.Marshal(config[name])
+		if err != nil {
+			return nil, fmt.Errorf("error encoding section %s: %v", name, err)
+		}
+		result[name] = string(encoded)
+	}
+
+	return result, nil
+}
+
+const configDriftSummary = "Admin Configuration Drift Detected"
+
+// configDriftDetail describes the drifted sections of a baseline.
+func configDriftDetail(name string, drifted []string) string {
+	return fmt.Sprintf("The following sections of baseline %q changed outside of Terraform: %s. "+
+		"Revert the change on the server, or update the baseline triggers to accept it.",
+		name, strings.Join(drifted, ", "))
+}
@@ -0,0 +1,324 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/configs"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ConnectionResource{}
+var _ resource.ResourceWithImportState = &ConnectionResource{}
+
+func NewConnectionResource() resource.Resource {
+	return &ConnectionResource{}
+}
+
+func NewOllamaConnectionResource() resource.Resource {
+	return &ConnectionResource{ollama: true}
+}
+
+// ConnectionResource defines the resource implementation. The same
+// implementation manages OpenAI compatible and Ollama API connections.
+type ConnectionResource struct {
+	client *configs.Client
+	ollama bool
+}
+
+// ConnectionResourceModel describes the resource data model.
+type ConnectionResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	URL             types.String `tfsdk:"url"`
+	APIKey          types.String `tfsdk:"api_key"`
+	APIKeyWO        types.String `tfsdk:"api_key_wo"`
+	APIKeyWOVersion types.Int64  `tfsdk:"api_key_wo_version"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	PrefixID        types.String `tfsdk:"prefix_id"`
+	ModelIDs        types.List   `tfsdk:"model_ids"`
+}
+
+func (r *ConnectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	if r.ollama {
+		resp.TypeName = req.ProviderTypeName + "_ollama_connection"
+		return
+	}
+	resp.TypeName = req.ProviderTypeName + "_connection"
+}
+
+func (r *ConnectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	description := "Connection resource for OpenWebUI. Manages a connection to an OpenAI compatible API, whose models are offered to users. "
+	urlDescription := "Base URL of the API, e.g. `https://api.openai.com/v1`"
+	keyDescription := "API key of the connection"
+	if r.ollama {
+		description = "Ollama connection resource for OpenWebUI. Manages a connection to an Ollama server, whose models are offered to users. "
+		urlDescription = "Base URL of the Ollama server, e.g. `http://ollama:11434`"
+		keyDescription = "API key of the connection, for Ollama servers behind an authenticating proxy"
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: description + "Requires an admin token. Connections added in the admin panel are left untouched",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the connection, which is its URL",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: urlDescription,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"api_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: keyDescription + ". It is stored in the Terraform state, use `api_key_wo` to avoid that",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("api_key_wo")),
+				},
+			},
+			"api_key_wo": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: keyDescription + " as a write-only argument, which is never stored in the plan or state. Requires Terraform 1.11 or later. Increment `api_key_wo_version` to send a new key",
+			},
+			"api_key_wo_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Any change to this value sends `api_key_wo` to OpenWebUI again",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("api_key_wo")),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the models of the connection are offered. Defaults to `true`",
+			},
+			"prefix_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Prefix added to the IDs of the models of the connection, to tell apart models with the same ID from different connections",
+			},
+			"model_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "IDs of the models offered by the connection. All models of the API are offered when unset",
+			},
+		},
+	}
+}
+
+func (r *ConnectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Configs
+}
+
+func (r *ConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ConnectionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, req.Config, true, &resp.State, &resp.Diagnostics)
+}
+
+func (r *ConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ConnectionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := r.get(ctx, data.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, apierror.ErrNotFound) {
+			// Deleted outside of Terraform, plan to create it again
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read connection, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setConnectionState(ctx, conn, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ConnectionResourceModel
+
+	// Read Terraform plan and prior state data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only keys are not in the state, so their version is compared instead
+	sendKeyWO := !data.APIKeyWOVersion.Equal(state.APIKeyWOVersion)
+
+	r.apply(ctx, &data, req.Config, sendKeyWO, &resp.State, &resp.Diagnostics)
+}
+
+func (r *ConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ConnectionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.delete(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete connection, got error: %s", err))
+		return
+	}
+}
+
+func (r *ConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("url"), req.ID)...)
+}
+
+// apply sends the connection and stores the result in state. The write-only
+// key is taken from config when sendKeyWO is set.
+func (r *ConnectionResource) apply(ctx context.Context, data *ConnectionResourceModel, config tfsdk.Config, sendKeyWO bool, state *tfsdk.State, diags *diag.Diagnostics) {
+	conn, d := connectionFromModel(ctx, data.URL, data.APIKey, data.Enabled, data.PrefixID, data.ModelIDs)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	if conn.Key == nil && sendKeyWO {
+		var keyWO types.String
+		diags.Append(config.GetAttribute(ctx, path.Root("api_key_wo"), &keyWO)...)
+		if diags.HasError() {
+			return
+		}
+		conn.Key = knownString(keyWO)
+	}
+
+	result, err := r.set(ctx, conn)
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update connection, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(result.URL)
+	diags.Append(setConnectionState(ctx, result, data)...)
+	if diags.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags.Append(state.Set(ctx, data)...)
+}
+
+func (r *ConnectionResource) get(ctx context.Context, url string) (*configs.Connection, error) {
+	if r.ollama {
+		return r.client.GetOllamaConnection(ctx, url)
+	}
+	return r.client.GetOpenAIConnection(ctx, url)
+}
+
+func (r *ConnectionResource) set(ctx context.Context, conn *configs.Connection) (*configs.Connection, error) {
+	if r.ollama {
+		return r.client.SetOllamaConnection(ctx, conn)
+	}
+	return r.client.SetOpenAIConnection(ctx, conn)
+}
+
+func (r *ConnectionResource) delete(ctx context.Context, url string) error {
+	if r.ollama {
+		return r.client.DeleteOllamaConnection(ctx, url)
+	}
+	return r.client.DeleteOpenAIConnection(ctx, url)
+}
+
+// connectionFromModel converts the connection attributes into the API
+// representation. Unset attributes clear the setting on the server.
+func connectionFromModel(ctx context.Context, url, key types.String, enabled types.Bool, prefixID types.String, modelIDs types.List) (*configs.Connection, diag.Diagnostics) {
+	ids := []string{}
+	var diags diag.Diagnostics
+	if !modelIDs.IsNull() && !modelIDs.IsUnknown() {
+		diags = modelIDs.ElementsAs(ctx, &ids, false)
+	}
+
+	prefix := prefixID.ValueString()
+	return &configs.Connection{
+		URL:      url.ValueString(),
+		Key:      knownString(key),
+		Enable:   enabled.ValueBoolPointer(),
+		PrefixID: &prefix,
+		ModelIDs: &ids,
+	}, diags
+}
+
+// setConnectionState copies the server representation of the connection into
+// the resource data. The API key is only read when it is managed through api_key.
+func setConnectionState(ctx context.Context, conn *configs.Connection, data *ConnectionResourceModel) diag.Diagnostics {
+	data.URL = types.StringValue(conn.URL)
+	if !data.APIKey.IsNull() {
+		data.APIKey = types.StringPointerValue(conn.Key)
+	}
+	data.Enabled = types.BoolPointerValue(conn.Enable)
+
+	data.PrefixID = types.StringNull()
+	if conn.PrefixID != nil && *conn.PrefixID != "" {
+		data.PrefixID = types.StringValue(*conn.PrefixID)
+	}
+
+	data.ModelIDs = types.ListNull(types.StringType)
+	if conn.ModelIDs != nil && len(*conn.ModelIDs) > 0 {
+		var diags diag.Diagnostics
+		data.ModelIDs, diags = types.ListValueFrom(ctx, types.StringType, *conn.ModelIDs)
+		return diags
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/auths"
+)
+
+var (
+	_ datasource.DataSource = &CurrentUserDataSource{}
+)
+
+type CurrentUserDataSourceModel struct {
+	ID    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Email types.String `tfsdk:"email"`
+	Role  types.String `tfsdk:"role"`
+}
+
+func NewCurrentUserDataSource() datasource.DataSource {
+	return &CurrentUserDataSource{}
+}
+
+type CurrentUserDataSource struct {
+	client *auths.Client
+}
+
+func (d *CurrentUserDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_current_user"
+}
+
+func (d *CurrentUserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Exposes the user the provider token belongs to, e.g. to assert with preconditions that changes are made by the expected service account.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the user.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the user.",
+				Computed:    true,
+			},
+			"email": schema.StringAttribute{
+				Description: "The email address of the user.",
+				Computed:    true,
+			},
+			"role": schema.StringAttribute{
+				Description: "The role of the user (pending, admin, or user).",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *CurrentUserDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = clients.Auths
+}
+
+func (d *CurrentUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	user, err := d.client.GetSessionUser(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read the user of the provider token, got error: %s", err))
+		return
+	}
+
+	state := CurrentUserDataSourceModel{
+		ID:    types.StringValue(user.ID),
+		Name:  types.StringValue(user.Name),
+		Email: types.StringValue(user.Email),
+		Role:  types.StringValue(user.Role),
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,176 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/configs"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &DefaultModelsResource{}
+var _ resource.ResourceWithImportState = &DefaultModelsResource{}
+
+func NewDefaultModelsResource() resource.Resource {
+	return &DefaultModelsResource{}
+}
+
+// DefaultModelsResource defines the resource implementation.
+type DefaultModelsResource struct {
+	client *configs.Client
+}
+
+// DefaultModelsResourceModel describes the resource data model.
+type DefaultModelsResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	ModelIDs types.List   `tfsdk:"model_ids"`
+}
+
+func (r *DefaultModelsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_default_models"
+}
+
+func (r *DefaultModelsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the models selected for new chats of users who have not chosen their own defaults. Requires an admin token. " +
+			"Destroying the resource leaves the settings unchanged",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Always `default_models`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"model_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				MarkdownDescription: "IDs of the default models. New chats start with all of them, side by side in this order. An empty list lets new chats start with the first available model",
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(regexp.MustCompile(`^[^,]+$`), "must not be empty or contain commas")),
+				},
+			},
+		},
+	}
+}
+
+func (r *DefaultModelsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Configs
+}
+
+func (r *DefaultModelsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DefaultModelsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *DefaultModelsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DefaultModelsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.GetModelsConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read default models, got error: %s", err))
+		return
+	}
+
+	var diags diag.Diagnostics
+	data.ModelIDs, diags = types.ListValueFrom(ctx, types.StringType, config.DefaultModelIDs())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DefaultModelsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DefaultModelsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *DefaultModelsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The settings always exist, so there is nothing to delete
+}
+
+func (r *DefaultModelsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply sends the configured models and stores the resulting setting in state.
+func (r *DefaultModelsResource) apply(ctx context.Context, data *DefaultModelsResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	ids := []string{}
+	diags.Append(data.ModelIDs.ElementsAs(ctx, &ids, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	defaultModels := strings.Join(ids, ",")
+	config, err := r.client.UpdateModelsConfig(ctx, &configs.ModelsConfig{DefaultModels: &defaultModels})
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update default models, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("default_models")
+	var d diag.Diagnostics
+	data.ModelIDs, d = types.ListValueFrom(ctx, types.StringType, config.DefaultModelIDs())
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags.Append(state.Set(ctx, data)...)
+}
@@ -0,0 +1,307 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/configs"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &EvaluationConfigResource{}
+var _ resource.ResourceWithImportState = &EvaluationConfigResource{}
+
+// arenaModelAttrTypes are the attribute types of an element of arena_models.
+var arenaModelAttrTypes = map[string]attr.Type{
+	"id":          types.StringType,
+	"name":        types.StringType,
+	"description": types.StringType,
+	"model_ids":   types.ListType{ElemType: types.StringType},
+}
+
+func NewEvaluationConfigResource() resource.Resource {
+	return &EvaluationConfigResource{}
+}
+
+// EvaluationConfigResource defines the resource implementation.
+type EvaluationConfigResource struct {
+	client *configs.Client
+}
+
+// EvaluationConfigResourceModel describes the resource data model.
+type EvaluationConfigResourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	EnableArenaModels      types.Bool   `tfsdk:"enable_arena_models"`
+	ArenaModels            types.List   `tfsdk:"arena_models"`
+	EnableMessageRating    types.Bool   `tfsdk:"enable_message_rating"`
+	EnableCommunitySharing types.Bool   `tfsdk:"enable_community_sharing"`
+}
+
+// arenaModelModel describes an element of arena_models.
+type arenaModelModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	ModelIDs    types.List   `tfsdk:"model_ids"`
+}
+
+func (r *EvaluationConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_evaluation_config"
+}
+
+func (r *EvaluationConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages model evaluations: the arena models that answer with a randomly selected model, and rating and sharing of responses. " +
+			"Requires an admin token. Settings that are not configured keep their current value. Destroying the resource leaves the settings unchanged",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Always `evaluation`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enable_arena_models": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether the arena models are available in the model selector",
+			},
+			"arena_models": schema.ListNestedAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Arena models. Replaces all arena models of the instance, including the built-in `arena-model`",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Model ID of the arena model",
+						},
+						"name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Display name of the arena model",
+						},
+						"description": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Description of the arena model",
+						},
+						"model_ids": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Optional:            true,
+							MarkdownDescription: "IDs of the models the arena model selects from. Selects from all models when unset",
+						},
+					},
+				},
+			},
+			"enable_message_rating": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether users can rate responses, which feeds the evaluation leaderboard",
+			},
+			"enable_community_sharing": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether users can share chats and feedback with the OpenWebUI community",
+			},
+		},
+	}
+}
+
+func (r *EvaluationConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Configs
+}
+
+func (r *EvaluationConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EvaluationConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *EvaluationConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EvaluationConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.GetEvaluationConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read evaluation config, got error: %s", err))
+		return
+	}
+
+	auth, err := r.client.GetAuthConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read auth config, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setEvaluationConfigState(ctx, config, auth, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EvaluationConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data EvaluationConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *EvaluationConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The settings always exist, so there is nothing to delete
+}
+
+func (r *EvaluationConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply sends the configured settings and stores the resulting configuration in state.
+// Message rating and community sharing are part of the auth settings.
+func (r *EvaluationConfigResource) apply(ctx context.Context, data *EvaluationConfigResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	arenaModels, d := knownArenaModels(ctx, data.ArenaModels)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	config, err := r.client.UpdateEvaluationConfig(ctx, &configs.EvaluationConfig{
+		EnableArenaModels: knownBool(data.EnableArenaModels),
+		ArenaModels:       arenaModels,
+	})
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update evaluation config, got error: %s", err))
+		return
+	}
+
+	auth, err := r.client.UpdateAuthConfig(ctx, &configs.AuthConfig{
+		EnableMessageRating:    knownBool(data.EnableMessageRating),
+		EnableCommunitySharing: knownBool(data.EnableCommunitySharing),
+	})
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update auth config, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("evaluation")
+	diags.Append(setEvaluationConfigState(ctx, config, auth, data)...)
+	if diags.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags.Append(state.Set(ctx, data)...)
+}
+
+// knownArenaModels converts arena_models into the API representation, or nil
+// when it is not configured.
+func knownArenaModels(ctx context.Context, v types.List) (*[]configs.ArenaModel, diag.Diagnostics) {
+	if v.IsNull() || v.IsUnknown() {
+		return nil, nil
+	}
+
+	var elements []arenaModelModel
+	diags := v.ElementsAs(ctx, &elements, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// Use the same profile image as arena models created in the admin panel
+	profileImageURL := "/favicon.png"
+
+	models := make([]configs.ArenaModel, 0, len(elements))
+	for _, e := range elements {
+		model := configs.ArenaModel{
+			ID:   e.ID.ValueString(),
+			Name: e.Name.ValueString(),
+			Meta: configs.ArenaModelMeta{
+				ProfileImageURL: &profileImageURL,
+				Description:     e.Description.ValueStringPointer(),
+			},
+		}
+		if !e.ModelIDs.IsNull() {
+			diags.Append(e.ModelIDs.ElementsAs(ctx, &model.Meta.ModelIDs, false)...)
+		}
+		models = append(models, model)
+	}
+	return &models, diags
+}
+
+// setEvaluationConfigState copies the server representation of the settings into the resource data.
+func setEvaluationConfigState(ctx context.Context, config *configs.EvaluationConfig, auth *configs.AuthConfig, data *EvaluationConfigResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.EnableArenaModels = types.BoolPointerValue(config.EnableArenaModels)
+	data.EnableMessageRating = types.BoolPointerValue(auth.EnableMessageRating)
+	data.EnableCommunitySharing = types.BoolPointerValue(auth.EnableCommunitySharing)
+
+	elementType := types.ObjectType{AttrTypes: arenaModelAttrTypes}
+	if config.ArenaModels == nil {
+		data.ArenaModels = types.ListNull(elementType)
+		return diags
+	}
+
+	elements := make([]arenaModelModel, 0, len(*config.ArenaModels))
+	for _, m := range *config.ArenaModels {
+		e := arenaModelModel{
+			ID:          types.StringValue(m.ID),
+			Name:        types.StringValue(m.Name),
+			Description: types.StringPointerValue(m.Meta.Description),
+			ModelIDs:    types.ListNull(types.StringType),
+		}
+		if m.Meta.ModelIDs != nil {
+			var d diag.Diagnostics
+			e.ModelIDs, d = types.ListValueFrom(ctx, types.StringType, m.Meta.ModelIDs)
+			diags.Append(d...)
+		}
+		elements = append(elements, e)
+	}
+
+	var d diag.Diagnostics
+	data.ArenaModels, d = types.ListValueFrom(ctx, elementType, elements)
+	diags.Append(d...)
+	return diags
+}
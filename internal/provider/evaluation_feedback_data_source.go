@@ -0,0 +1,134 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/evaluations"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &EvaluationFeedbackDataSource{}
+
+func NewEvaluationFeedbackDataSource() datasource.DataSource {
+	return &EvaluationFeedbackDataSource{}
+}
+
+// EvaluationFeedbackDataSource defines the data source implementation.
+type EvaluationFeedbackDataSource struct {
+	client *evaluations.Client
+}
+
+// EvaluationFeedbackDataSourceModel describes the data source data model.
+type EvaluationFeedbackDataSourceModel struct {
+	Total  types.Int64              `tfsdk:"total"`
+	Models []ModelRatingsEntryModel `tfsdk:"models"`
+}
+
+// ModelRatingsEntryModel describes the rating counts of a single model.
+type ModelRatingsEntryModel struct {
+	ModelID     types.String `tfsdk:"model_id"`
+	Positive    types.Int64  `tfsdk:"positive"`
+	Negative    types.Int64  `tfsdk:"negative"`
+	Total       types.Int64  `tfsdk:"total"`
+	LastRatedAt types.Int64  `tfsdk:"last_rated_at"`
+}
+
+func (d *EvaluationFeedbackDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_evaluation_feedback"
+}
+
+func (d *EvaluationFeedbackDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Evaluation feedback data source for OpenWebUI. Counts the thumbs up and thumbs down ratings users gave to the responses of each model, e.g. for reporting. Requires an admin token.",
+
+		Attributes: map[string]schema.Attribute{
+			"total": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Total number of ratings across all models",
+			},
+			"models": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Rated models, ordered by model ID",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"model_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Identifier of the model",
+						},
+						"positive": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of thumbs up ratings",
+						},
+						"negative": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of thumbs down ratings",
+						},
+						"total": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Total number of ratings of the model",
+						},
+						"last_rated_at": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Timestamp of the most recent rating of the model",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *EvaluationFeedbackDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = clients.Evaluations
+}
+
+func (d *EvaluationFeedbackDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EvaluationFeedbackDataSourceModel
+
+	// Get feedback records from API
+	feedbacks, err := d.client.ListFeedbacks(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read feedbacks, got error: %s", err))
+		return
+	}
+
+	ratings := evaluations.RatingCounts(feedbacks)
+
+	var total int64
+	data.Models = make([]ModelRatingsEntryModel, 0, len(ratings))
+	for _, entry := range ratings {
+		total += entry.Positive + entry.Negative
+		data.Models = append(data.Models, ModelRatingsEntryModel{
+			ModelID:     types.StringValue(entry.ModelID),
+			Positive:    types.Int64Value(entry.Positive),
+			Negative:    types.Int64Value(entry.Negative),
+			Total:       types.Int64Value(entry.Positive + entry.Negative),
+			LastRatedAt: types.Int64Value(entry.LastRatedAt),
+		})
+	}
+	data.Total = types.Int64Value(total)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,133 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/evaluations"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &EvaluationLeaderboardDataSource{}
+
+func NewEvaluationLeaderboardDataSource() datasource.DataSource {
+	return &EvaluationLeaderboardDataSource{}
+}
+
+// EvaluationLeaderboardDataSource defines the data source implementation.
+type EvaluationLeaderboardDataSource struct {
+	client *evaluations.Client
+}
+
+// EvaluationLeaderboardDataSourceModel describes the data source data model.
+type EvaluationLeaderboardDataSourceModel struct {
+	Models []LeaderboardEntryModel `tfsdk:"models"`
+}
+
+// LeaderboardEntryModel describes a single leaderboard entry.
+type LeaderboardEntryModel struct {
+	Rank    types.Int64  `tfsdk:"rank"`
+	ModelID types.String `tfsdk:"model_id"`
+	Rating  types.Int64  `tfsdk:"rating"`
+	Won     types.Int64  `tfsdk:"won"`
+	Lost    types.Int64  `tfsdk:"lost"`
+	Total   types.Int64  `tfsdk:"total"`
+}
+
+func (d *EvaluationLeaderboardDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_evaluation_leaderboard"
+}
+
+func (d *EvaluationLeaderboardDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Evaluation leaderboard data source for OpenWebUI. Ratings are Elo scores computed from arena feedback the same way the admin panel does. Requires an admin token.",
+
+		Attributes: map[string]schema.Attribute{
+			"models": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Rated models, ordered from highest to lowest rating",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"rank": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Position of the model on the leaderboard, starting at 1",
+						},
+						"model_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Identifier of the model",
+						},
+						"rating": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Elo rating of the model, rounded to the nearest integer",
+						},
+						"won": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of comparisons the model won",
+						},
+						"lost": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of comparisons the model lost",
+						},
+						"total": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Total number of comparisons the model took part in",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *EvaluationLeaderboardDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = clients.Evaluations
+}
+
+func (d *EvaluationLeaderboardDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EvaluationLeaderboardDataSourceModel
+
+	// Get feedback records from API
+	feedbacks, err := d.client.ListFeedbacks(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read feedbacks, got error: %s", err))
+		return
+	}
+
+	leaderboard := evaluations.Leaderboard(feedbacks)
+
+	data.Models = make([]LeaderboardEntryModel, 0, len(leaderboard))
+	for i, entry := range leaderboard {
+		data.Models = append(data.Models, LeaderboardEntryModel{
+			Rank:    types.Int64Value(int64(i + 1)),
+			ModelID: types.StringValue(entry.ModelID),
+			Rating:  types.Int64Value(int64(math.Round(entry.Rating))),
+			Won:     types.Int64Value(entry.Won),
+			Lost:    types.Int64Value(entry.Lost),
+			Total:   types.Int64Value(entry.Won + entry.Lost),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,365 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/files"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &FileResource{}
+var _ resource.ResourceWithModifyPlan = &FileResource{}
+
+func NewFileResource() resource.Resource {
+	return &FileResource{}
+}
+
+// FileResource defines the resource implementation.
+type FileResource struct {
+	client *files.Client
+}
+
+// FileResourceModel describes the resource data model.
+type FileResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Source      types.String `tfsdk:"source"`
+	Content     types.String `tfsdk:"content"`
+	Filename    types.String `tfsdk:"filename"`
+	ContentHash types.String `tfsdk:"content_sha256"`
+	Hash        types.String `tfsdk:"hash"`
+	ContentType types.String `tfsdk:"content_type"`
+	Size        types.Int64  `tfsdk:"size"`
+	UserID      types.String `tfsdk:"user_id"`
+	CreatedAt   types.Int64  `tfsdk:"created_at"`
+	Wait        types.Bool   `tfsdk:"wait_for_processing"`
+	WaitTimeout types.Int64  `tfsdk:"processing_timeout"`
+}
+
+func (r *FileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file"
+}
+
+func (r *FileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Uploads a file to the OpenWebUI file store without attaching it to a knowledge base, e.g. for files referenced by tools. " +
+			"The file is uploaded again when its content changes, and deleted on destroy",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the uploaded file",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Path to the local file to upload. Exactly one of `source` or `content` must be set",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("content")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Content to upload, e.g. rendered with `templatefile`. Requires `filename`",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("filename")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"filename": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Name of the uploaded file. Defaults to the base name of `source`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"content_sha256": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "SHA-256 of the uploaded content. When the local file no longer matches it, the file is uploaded again",
+			},
+			"hash": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Content hash recorded by the server",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"content_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "MIME type of the file",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Size of the file in bytes",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the user who uploaded the file",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp when the file was uploaded",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"wait_for_processing": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to wait until OpenWebUI has finished processing the upload, so that dependent resources see an indexed file",
+			},
+			"processing_timeout": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(300),
+				MarkdownDescription: "Maximum number of seconds to wait for processing when `wait_for_processing` is enabled",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *FileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Files
+}
+
+// ModifyPlan hashes the content so that only changed content is uploaded again.
+func (r *FileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan FileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Source.IsUnknown() || plan.Content.IsUnknown() {
+		return
+	}
+
+	hash, err := plannedFileSHA256(&plan)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Unable to Read Source File", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), types.StringValue(hash))...)
+
+	// An unset filename follows the base name of the source
+	var filename types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("filename"), &filename)...)
+	if filename.IsNull() && !plan.Source.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("filename"), types.StringValue(filepath.Base(plan.Source.ValueString())))...)
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state FileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ContentHash.ValueString() != hash {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("content_sha256"))
+	}
+}
+
+func (r *FileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FileResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hash, err := plannedFileSHA256(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Source File", fmt.Sprintf("Unable to hash %s, got error: %s", data.Source.ValueString(), err))
+		return
+	}
+
+	var content io.Reader = strings.NewReader(data.Content.ValueString())
+	filename := data.Filename.ValueString()
+	if source := data.Source.ValueString(); source != "" {
+		f, err := os.Open(source)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Read Source File", fmt.Sprintf("Unable to open %s, got error: %s", source, err))
+			return
+		}
+		defer f.Close()
+
+		content = f
+		if filename == "" {
+			filename = filepath.Base(source)
+		}
+	}
+
+	file, err := r.client.Upload(ctx, filename, content)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to upload file %s, got error: %s", filename, err))
+		return
+	}
+
+	if data.Wait.ValueBool() {
+		timeout := time.Duration(data.WaitTimeout.ValueInt64()) * time.Second
+		if err := waitForFileProcessing(ctx, r.client, file.ID, timeout); err != nil {
+			resp.Diagnostics.AddError("File Processing Failed", fmt.Sprintf("File %s (%s) was not processed: %s", filename, file.ID, err))
+			if err := r.client.Delete(ctx, file.ID); err != nil {
+				resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete uploaded file %s, got error: %s", file.ID, err))
+			}
+			return
+		}
+	}
+
+	// The upload response does not include the processing results, so read the file back
+	file, err = r.client.Get(ctx, file.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read file after upload, got error: %s", err))
+		return
+	}
+
+	data.ContentHash = types.StringValue(hash)
+	setFileState(file, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	file, err := r.client.Get(ctx, data.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, apierror.ErrNotFound) {
+			// Deleted outside of Terraform, plan to upload it again
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read file, got error: %s", err))
+		return
+	}
+
+	setFileState(file, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FileResourceModel
+
+	// Every attribute sent to the server requires replacement, so only the plan is stored
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Delete(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete file, got error: %s", err))
+		return
+	}
+}
+
+// plannedFileSHA256 hashes the local source file, or the inline content when no source is set.
+func plannedFileSHA256(data *FileResourceModel) (string, error) {
+	if !data.Source.IsNull() {
+		return fileSHA256(data.Source.ValueString())
+	}
+	sum := sha256.Sum256([]byte(data.Content.ValueString()))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// setFileState copies the server representation of a file into the resource data.
+func setFileState(file *files.File, data *FileResourceModel) {
+	data.ID = types.StringValue(file.ID)
+	data.Filename = types.StringValue(file.Filename)
+	data.Hash = types.StringPointerValue(file.Hash)
+	data.UserID = types.StringValue(file.UserID)
+	data.CreatedAt = types.Int64Value(file.CreatedAt)
+
+	data.ContentType = types.StringNull()
+	data.Size = types.Int64Null()
+	if file.Meta != nil {
+		data.ContentType = types.StringPointerValue(file.Meta.ContentType)
+		data.Size = types.Int64PointerValue(file.Meta.Size)
+	}
+}
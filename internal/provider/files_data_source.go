@@ -0,0 +1,176 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/files"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &FileDataSource{}
+
+func NewFileDataSource() datasource.DataSource {
+	return &FileDataSource{}
+}
+
+// FileDataSource defines the data source implementation.
+type FileDataSource struct {
+	client *files.Client
+}
+
+// FileDataSourceModel describes the data source data model.
+type FileDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Hash        types.String `tfsdk:"hash"`
+	UserID      types.String `tfsdk:"user_id"`
+	Filename    types.String `tfsdk:"filename"`
+	ContentType types.String `tfsdk:"content_type"`
+	Size        types.Int64  `tfsdk:"size"`
+	Status      types.String `tfsdk:"status"`
+	Error       types.String `tfsdk:"error"`
+	CreatedAt   types.Int64  `tfsdk:"created_at"`
+	UpdatedAt   types.Int64  `tfsdk:"updated_at"`
+}
+
+func (d *FileDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file"
+}
+
+func (d *FileDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "File data source for OpenWebUI. Looks up an uploaded file by `id` or `hash` and returns its metadata and processing status.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "File identifier. Exactly one of `id` or `hash` must be set",
+			},
+			"hash": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Content hash of the file. Exactly one of `id` or `hash` must be set",
+			},
+			"user_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the user who uploaded the file",
+			},
+			"filename": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Name of the uploaded file",
+			},
+			"content_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "MIME type of the file",
+			},
+			"size": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Size of the file in bytes",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Processing status of the file (e.g. `pending`, `completed`, `failed`). Empty if the server does not report one",
+			},
+			"error": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Processing error reported by the server, if any",
+			},
+			"created_at": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp when the file was uploaded",
+			},
+			"updated_at": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp when the file was last updated",
+			},
+		},
+	}
+}
+
+func (d *FileDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = clients.Files
+}
+
+func (d *FileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FileDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsNull() == data.Hash.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid search criteria",
+			"Exactly one of id or hash must be provided",
+		)
+		return
+	}
+
+	var file *files.File
+	var err error
+	if !data.ID.IsNull() {
+		file, err = d.client.Get(ctx, data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read file %s, got error: %s", data.ID.ValueString(), err))
+			return
+		}
+	} else {
+		file, err = d.client.FindByHash(ctx, data.Hash.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to find file with hash %s, got error: %s", data.Hash.ValueString(), err))
+			return
+		}
+	}
+
+	// Convert API response to model
+	data.ID = types.StringValue(file.ID)
+	data.UserID = types.StringValue(file.UserID)
+	data.Filename = types.StringValue(file.Filename)
+	data.Status = types.StringValue(file.ProcessingStatus())
+	data.Error = types.StringValue(file.ProcessingError())
+	data.CreatedAt = types.Int64Value(file.CreatedAt)
+	data.UpdatedAt = types.Int64Value(file.UpdatedAt)
+
+	if file.Hash != nil {
+		data.Hash = types.StringValue(*file.Hash)
+	} else {
+		data.Hash = types.StringNull()
+	}
+
+	data.ContentType = types.StringNull()
+	data.Size = types.Int64Null()
+	if file.Meta != nil {
+		if file.Meta.ContentType != nil {
+			data.ContentType = types.StringValue(*file.Meta.ContentType)
+		}
+		if file.Meta.Size != nil {
+			data.Size = types.Int64Value(*file.Meta.Size)
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,316 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/folders"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &FolderResource{}
+var _ resource.ResourceWithImportState = &FolderResource{}
+
+func NewFolderResource() resource.Resource {
+	return &FolderResource{}
+}
+
+// FolderResource defines the resource implementation.
+type FolderResource struct {
+	client *folders.Client
+}
+
+// FolderResourceModel describes the resource data model.
+type FolderResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	ParentID     types.String `tfsdk:"parent_id"`
+	SystemPrompt types.String `tfsdk:"system_prompt"`
+	ModelIDs     types.List   `tfsdk:"model_ids"`
+	UserID       types.String `tfsdk:"user_id"`
+	CreatedAt    types.Int64  `tfsdk:"created_at"`
+	UpdatedAt    types.Int64  `tfsdk:"updated_at"`
+}
+
+func (r *FolderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folder"
+}
+
+func (r *FolderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Folder resource for OpenWebUI. Folders group chats in the sidebar and can set defaults for new chats. " +
+			"Folders are owned by a user, so they are created for the user the provider token was issued for",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Folder identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the folder. Must be unique among the folders sharing the same parent",
+			},
+			"parent_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Identifier of the parent folder. Unset for a top level folder",
+			},
+			"system_prompt": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "System prompt for chats in the folder",
+			},
+			"model_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Models selected by default for new chats in the folder",
+			},
+			"user_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the user owning the folder",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp when the folder was created",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp when the folder was last updated",
+			},
+		},
+	}
+}
+
+func (r *FolderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Folders
+}
+
+func (r *FolderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FolderResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	form, diags := folderForm(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Folders are always created at the top level and moved afterwards
+	folder, err := r.client.Create(ctx, form)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to create folder, got error: %s", err))
+		return
+	}
+	data.ID = types.StringValue(folder.ID)
+
+	if !data.ParentID.IsNull() {
+		if err := r.client.UpdateParent(ctx, folder.ID, data.ParentID.ValueStringPointer()); err != nil {
+			resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to move folder, got error: %s", err))
+			if err := r.client.Delete(ctx, folder.ID); err != nil {
+				resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete folder %s, got error: %s", folder.ID, err))
+			}
+			return
+		}
+	}
+
+	folder, err = r.client.Get(ctx, folder.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read folder, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setFolderState(ctx, folder, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FolderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FolderResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folder, err := r.client.Get(ctx, data.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, apierror.ErrNotFound) {
+			// Deleted outside of Terraform, plan to create it again
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read folder, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setFolderState(ctx, folder, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FolderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state FolderResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+
+	form, diags := folderForm(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Update(ctx, id, form); err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update folder, got error: %s", err))
+		return
+	}
+
+	if !data.ParentID.Equal(state.ParentID) {
+		if err := r.client.UpdateParent(ctx, id, data.ParentID.ValueStringPointer()); err != nil {
+			resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to move folder, got error: %s", err))
+			return
+		}
+	}
+
+	folder, err := r.client.Get(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read folder, got error: %s", err))
+		return
+	}
+
+	data.ID = state.ID
+	resp.Diagnostics.Append(setFolderState(ctx, folder, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FolderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FolderResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Delete(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete folder, got error: %s", err))
+		return
+	}
+}
+
+func (r *FolderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// folderForm converts the planned resource data into the API payload.
+func folderForm(ctx context.Context, data *FolderResourceModel) (*folders.FolderForm, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	form := &folders.FolderForm{
+		Name: data.Name.ValueString(),
+		Data: &folders.FolderData{
+			SystemPrompt: data.SystemPrompt.ValueStringPointer(),
+			ModelIDs:     []string{},
+		},
+	}
+
+	if !data.ModelIDs.IsNull() && !data.ModelIDs.IsUnknown() {
+		diags.Append(data.ModelIDs.ElementsAs(ctx, &form.Data.ModelIDs, false)...)
+	}
+
+	return form, diags
+}
+
+// setFolderState copies the server representation of a folder into the resource data.
+func setFolderState(ctx context.Context, folder *folders.Folder, data *FolderResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(folder.ID)
+	data.Name = types.StringValue(folder.Name)
+	data.ParentID = types.StringPointerValue(folder.ParentID)
+	data.UserID = types.StringValue(folder.UserID)
+	data.CreatedAt = types.Int64Value(folder.CreatedAt)
+	data.UpdatedAt = types.Int64Value(folder.UpdatedAt)
+
+	var systemPrompt *string
+	var modelIDs []string
+	if folder.Data != nil {
+		systemPrompt = folder.Data.SystemPrompt
+		modelIDs = folder.Data.ModelIDs
+	}
+
+	if systemPrompt != nil && (*systemPrompt != "" || !data.SystemPrompt.IsNull()) {
+		data.SystemPrompt = types.StringValue(*systemPrompt)
+	} else {
+		data.SystemPrompt = types.StringNull()
+	}
+
+	if len(modelIDs) == 0 && data.ModelIDs.IsNull() {
+		return diags
+	}
+
+	list, d := types.ListValueFrom(ctx, types.StringType, append([]string{}, modelIDs...))
+	diags.Append(d...)
+	data.ModelIDs = list
+
+	return diags
+}
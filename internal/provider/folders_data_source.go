@@ -0,0 +1,160 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/folders"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &FolderDataSource{}
+
+func NewFolderDataSource() datasource.DataSource {
+	return &FolderDataSource{}
+}
+
+// FolderDataSource defines the data source implementation.
+type FolderDataSource struct {
+	client *folders.Client
+}
+
+// FolderDataSourceModel describes the data source data model.
+type FolderDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	ParentID   types.String `tfsdk:"parent_id"`
+	UserID     types.String `tfsdk:"user_id"`
+	IsExpanded types.Bool   `tfsdk:"is_expanded"`
+	CreatedAt  types.Int64  `tfsdk:"created_at"`
+	UpdatedAt  types.Int64  `tfsdk:"updated_at"`
+}
+
+func (d *FolderDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folder"
+}
+
+func (d *FolderDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Folder data source for OpenWebUI. Folders are owned by a user, so the lookup only sees folders belonging to the user the provider token was issued for.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Folder identifier",
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the folder to look up",
+				Required:            true,
+			},
+			"parent_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Identifier of the parent folder. Set this to disambiguate folders sharing the same name",
+			},
+			"user_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the user owning the folder",
+			},
+			"is_expanded": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the folder is expanded in the sidebar",
+			},
+			"created_at": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp when the folder was created",
+			},
+			"updated_at": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp when the folder was last updated",
+			},
+		},
+	}
+}
+
+func (d *FolderDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = clients.Folders
+}
+
+func (d *FolderDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FolderDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get folders from API
+	folderList, err := d.client.List(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read folders, got error: %s", err))
+		return
+	}
+
+	// Find the folders with matching name (and parent, if given)
+	var matches []folders.Folder
+	for _, folder := range folderList {
+		if folder.Name != data.Name.ValueString() {
+			continue
+		}
+		if !data.ParentID.IsNull() {
+			if folder.ParentID == nil || *folder.ParentID != data.ParentID.ValueString() {
+				continue
+			}
+		}
+		matches = append(matches, folder)
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError(
+			"Folder Not Found",
+			fmt.Sprintf("No folder found with name: %s", data.Name.ValueString()),
+		)
+		return
+	}
+
+	if len(matches) > 1 {
+		resp.Diagnostics.AddError(
+			"Multiple Folders Found",
+			fmt.Sprintf("Found %d folders with name %s. Set parent_id to select a single folder.", len(matches), data.Name.ValueString()),
+		)
+		return
+	}
+
+	// Convert API response to model
+	folder := matches[0]
+	data.ID = types.StringValue(folder.ID)
+	data.UserID = types.StringValue(folder.UserID)
+	data.IsExpanded = types.BoolValue(folder.IsExpanded)
+	data.CreatedAt = types.Int64Value(folder.CreatedAt)
+	data.UpdatedAt = types.Int64Value(folder.UpdatedAt)
+	if folder.ParentID != nil {
+		data.ParentID = types.StringValue(*folder.ParentID)
+	} else {
+		data.ParentID = types.StringNull()
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,216 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/functions"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &FunctionToggleResource{}
+var _ resource.ResourceWithImportState = &FunctionToggleResource{}
+
+func NewFunctionToggleResource() resource.Resource {
+	return &FunctionToggleResource{}
+}
+
+// FunctionToggleResource defines the resource implementation.
+type FunctionToggleResource struct {
+	client *functions.Client
+}
+
+// FunctionToggleResourceModel describes the resource data model.
+type FunctionToggleResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	FunctionID types.String `tfsdk:"function_id"`
+	IsActive   types.Bool   `tfsdk:"is_active"`
+	IsGlobal   types.Bool   `tfsdk:"is_global"`
+	Name       types.String `tfsdk:"name"`
+	Type       types.String `tfsdk:"type"`
+}
+
+func (r *FunctionToggleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_function_toggle"
+}
+
+func (r *FunctionToggleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Function toggle resource for OpenWebUI. Manages whether an installed function (pipe, filter or action) is active, " +
+			"and whether it applies to all models. Requires an admin token. Toggles that are not configured keep their current value. " +
+			"Destroying the resource leaves the function unchanged",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the resource, which is the function ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"function_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the installed function",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_active": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether the function is active. Inactive functions are not run and not offered to models",
+			},
+			"is_global": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether the function applies to all models, instead of only the models it is enabled for. Only used for filters and actions",
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Display name of the function",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Type of the function (`pipe`, `filter` or `action`)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *FunctionToggleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Functions
+}
+
+func (r *FunctionToggleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FunctionToggleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *FunctionToggleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FunctionToggleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	function, err := r.client.Get(ctx, data.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, apierror.ErrNotFound) {
+			// The function was deleted, so there is nothing left to toggle
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read function, got error: %s", err))
+		return
+	}
+
+	setFunctionToggleState(function, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FunctionToggleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FunctionToggleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *FunctionToggleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The function is left as it is, uninstalling it is not part of this resource
+}
+
+func (r *FunctionToggleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("function_id"), req.ID)...)
+}
+
+// apply flips the toggles that differ from the plan and stores the result in
+// state. The API only offers toggles, so the current values are read first.
+func (r *FunctionToggleResource) apply(ctx context.Context, data *FunctionToggleResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	id := data.FunctionID.ValueString()
+
+	function, err := r.client.Get(ctx, id)
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read function, got error: %s", err))
+		return
+	}
+
+	if isActive := knownBool(data.IsActive); isActive != nil && *isActive != function.IsActive {
+		function, err = r.client.ToggleActive(ctx, id)
+		if err != nil {
+			diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to toggle function, got error: %s", err))
+			return
+		}
+	}
+
+	if isGlobal := knownBool(data.IsGlobal); isGlobal != nil && *isGlobal != function.IsGlobal {
+		function, err = r.client.ToggleGlobal(ctx, id)
+		if err != nil {
+			diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to toggle function, got error: %s", err))
+			return
+		}
+	}
+
+	data.ID = types.StringValue(id)
+	setFunctionToggleState(function, data)
+
+	// Save data into Terraform state
+	diags.Append(state.Set(ctx, data)...)
+}
+
+// setFunctionToggleState copies the server values into the resource data
+func setFunctionToggleState(function *functions.Function, data *FunctionToggleResourceModel) {
+	data.IsActive = types.BoolValue(function.IsActive)
+	data.IsGlobal = types.BoolValue(function.IsGlobal)
+	data.Name = types.StringValue(function.Name)
+	data.Type = types.StringValue(function.Type)
+}
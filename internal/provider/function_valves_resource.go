@@ -0,0 +1,298 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/functions"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &FunctionValvesResource{}
+var _ resource.ResourceWithImportState = &FunctionValvesResource{}
+
+func NewFunctionValvesResource() resource.Resource {
+	return &FunctionValvesResource{}
+}
+
+// FunctionValvesResource defines the resource implementation.
+type FunctionValvesResource struct {
+	client *functions.Client
+}
+
+// FunctionValvesResourceModel describes the resource data model.
+type FunctionValvesResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	FunctionID      types.String `tfsdk:"function_id"`
+	Valves          types.Map    `tfsdk:"valves"`
+	SensitiveValves types.Map    `tfsdk:"sensitive_valves"`
+}
+
+func (r *FunctionValvesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_function_valves"
+}
+
+func (r *FunctionValvesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Function valves resource for OpenWebUI. Sets the valves (settings) of an installed function, e.g. the API key of a pipe. " +
+			"Requires an admin token. Valves that are not set keep their current value and are not tracked. Destroying the resource leaves the valves unchanged",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the resource, which is the function ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"function_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the installed function",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"valves": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				MarkdownDescription: "Valve values of the function. Values are converted to the type of the valve, so numbers, booleans and lists are written as JSON, e.g. `\"60\"` or `jsonencode([\"*\"])`. " +
+					"Use `sensitive_valves` for secrets",
+				Validators: []validator.Map{
+					mapvalidator.AtLeastOneOf(path.MatchRoot("sensitive_valves")),
+				},
+			},
+			"sensitive_valves": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Valve values that are hidden in the plan output, e.g. API keys. Values are converted like `valves`, and a valve cannot be in both maps",
+			},
+		},
+	}
+}
+
+func (r *FunctionValvesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Functions
+}
+
+func (r *FunctionValvesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FunctionValvesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *FunctionValvesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FunctionValvesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.client.GetValves(ctx, data.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, apierror.ErrNotFound) {
+			// The function was deleted, so its valves are gone too
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read function valves, got error: %s", err))
+		return
+	}
+
+	data.FunctionID = data.ID
+	resp.Diagnostics.Append(setValvesState(ctx, current, &data.Valves, &data.SensitiveValves)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FunctionValvesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FunctionValvesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *FunctionValvesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The valves are left as they are, the function keeps using them
+}
+
+func (r *FunctionValvesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply sets the configured valves and stores the result in state
+func (r *FunctionValvesResource) apply(ctx context.Context, data *FunctionValvesResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	id := data.FunctionID.ValueString()
+
+	configured := configuredValves(ctx, data.Valves, data.SensitiveValves, diags)
+	if diags.HasError() {
+		return
+	}
+
+	current, err := r.client.GetValves(ctx, id)
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read function valves, got error: %s", err))
+		return
+	}
+	spec, err := r.client.GetValvesSpec(ctx, id)
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read function valves, got error: %s", err))
+		return
+	}
+
+	result, err := r.client.UpdateValves(ctx, id, typedValves(configured, current, spec))
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update function valves, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(id)
+	diags.Append(setValvesState(ctx, result, &data.Valves, &data.SensitiveValves)...)
+	if diags.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags.Append(state.Set(ctx, data)...)
+}
+
+// configuredValves merges the valves and sensitive valves maps, reporting
+// valves that are in both.
+func configuredValves(ctx context.Context, valves, sensitiveValves types.Map, diags *diag.Diagnostics) map[string]string {
+	configured := map[string]string{}
+	if !valves.IsNull() && !valves.IsUnknown() {
+		diags.Append(valves.ElementsAs(ctx, &configured, false)...)
+	}
+
+	sensitive := map[string]string{}
+	if !sensitiveValves.IsNull() && !sensitiveValves.IsUnknown() {
+		diags.Append(sensitiveValves.ElementsAs(ctx, &sensitive, false)...)
+	}
+
+	var duplicates []string
+	for key, value := range sensitive {
+		if _, ok := configured[key]; ok {
+			duplicates = append(duplicates, key)
+		}
+		configured[key] = value
+	}
+	if len(duplicates) > 0 {
+		sort.Strings(duplicates)
+		diags.AddAttributeError(path.Root("sensitive_valves"), "Duplicate Valves",
+			fmt.Sprintf("Valves cannot be in both valves and sensitive_valves: %s", strings.Join(duplicates, ", ")))
+	}
+	return configured
+}
+
+// typedValves converts the configured valves to the type of their current
+// value. Valves without a value are typed from the JSON schema in spec.
+func typedValves(configured map[string]string, current, spec map[string]interface{}) map[string]interface{} {
+	properties, _ := spec["properties"].(map[string]interface{})
+
+	valves := make(map[string]interface{}, len(configured))
+	for key, value := range configured {
+		typed := current[key]
+		if typed == nil {
+			property, _ := properties[key].(map[string]interface{})
+			typed = valveZeroValue(property)
+		}
+		valves[key] = valveValue(typed, value)
+	}
+	return valves
+}
+
+// valveZeroValue returns a value of the type of a valve JSON schema property,
+// or nil when the property has no single type.
+func valveZeroValue(property map[string]interface{}) interface{} {
+	if property["default"] != nil {
+		return property["default"]
+	}
+	switch property["type"] {
+	case "integer", "number":
+		return 0.0
+	case "boolean":
+		return false
+	case "array":
+		return []interface{}{}
+	case "object":
+		return map[string]interface{}{}
+	}
+	return nil
+}
+
+// setValvesState reads the current values of the tracked valves into the two
+// maps, converting them back to the configured notation.
+func setValvesState(ctx context.Context, current map[string]interface{}, valves, sensitiveValves *types.Map) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, m := range []*types.Map{valves, sensitiveValves} {
+		if m.IsNull() || m.IsUnknown() {
+			continue
+		}
+
+		configured := map[string]string{}
+		diags.Append(m.ElementsAs(ctx, &configured, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		tracked := make(map[string]string, len(configured))
+		for key, value := range configured {
+			if v, ok := current[key]; ok && v != nil {
+				tracked[key] = valveString(v, value)
+			}
+		}
+
+		var d diag.Diagnostics
+		*m, d = types.MapValueFrom(ctx, types.StringType, tracked)
+		diags.Append(d...)
+	}
+	return diags
+}
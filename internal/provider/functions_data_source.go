@@ -0,0 +1,157 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/functions"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &FunctionsDataSource{}
+
+func NewFunctionsDataSource() datasource.DataSource {
+	return &FunctionsDataSource{}
+}
+
+// FunctionsDataSource defines the data source implementation.
+type FunctionsDataSource struct {
+	client *functions.Client
+}
+
+// FunctionsDataSourceModel describes the data source data model.
+type FunctionsDataSourceModel struct {
+	Type      types.String    `tfsdk:"type"`
+	Functions []FunctionModel `tfsdk:"functions"`
+}
+
+// FunctionModel describes a single function.
+type FunctionModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	Description types.String `tfsdk:"description"`
+	IsActive    types.Bool   `tfsdk:"is_active"`
+	IsGlobal    types.Bool   `tfsdk:"is_global"`
+}
+
+func (d *FunctionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_functions"
+}
+
+func (d *FunctionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the functions (pipes, filters and actions) installed on the OpenWebUI instance. Requires an admin token.",
+
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return functions of this type. One of `pipe`, `filter` or `action`",
+				Validators: []validator.String{
+					stringvalidator.OneOf("pipe", "filter", "action"),
+				},
+			},
+			"functions": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Matching functions, ordered by ID",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Function identifier",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Display name of the function",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Type of the function (`pipe`, `filter` or `action`)",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Description of the function, if set",
+						},
+						"is_active": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the function is enabled",
+						},
+						"is_global": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the function applies to all models",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *FunctionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = clients.Functions
+}
+
+func (d *FunctionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FunctionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get functions from API
+	list, err := d.client.List(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to list functions, got error: %s", err))
+		return
+	}
+
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+
+	data.Functions = make([]FunctionModel, 0, len(list))
+	for _, function := range list {
+		if !data.Type.IsNull() && function.Type != data.Type.ValueString() {
+			continue
+		}
+
+		model := FunctionModel{
+			ID:          types.StringValue(function.ID),
+			Name:        types.StringValue(function.Name),
+			Type:        types.StringValue(function.Type),
+			Description: types.StringNull(),
+			IsActive:    types.BoolValue(function.IsActive),
+			IsGlobal:    types.BoolValue(function.IsGlobal),
+		}
+		if function.Meta.Description != nil {
+			model.Description = types.StringValue(*function.Meta.Description)
+		}
+		data.Functions = append(data.Functions, model)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
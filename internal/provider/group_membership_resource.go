@@ -0,0 +1,387 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/groups"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/users"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &GroupMembershipResource{}
+var _ resource.ResourceWithImportState = &GroupMembershipResource{}
+
+func NewGroupMembershipResource() resource.Resource {
+	return &GroupMembershipResource{}
+}
+
+// GroupMembershipResource defines the resource implementation.
+type GroupMembershipResource struct {
+	client      *groups.Client
+	usersClient *users.Client
+}
+
+// GroupMembershipResourceModel describes the resource data model.
+type GroupMembershipResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	GroupID   types.String `tfsdk:"group_id"`
+	UserIDs   types.Set    `tfsdk:"user_ids"`
+	Emails    types.Set    `tfsdk:"emails"`
+	Exclusive types.Bool   `tfsdk:"exclusive"`
+}
+
+func (r *GroupMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_membership"
+}
+
+func (r *GroupMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the members of an existing group. Do not combine with user_ids on the openwebui_group resource for the same group.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the membership (same as group_id).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Description: "Identifier of the group.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_ids": schema.SetAttribute{
+				Description: "IDs of the users that are members of the group.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"emails": schema.SetAttribute{
+				Description: "Email addresses of the users that are members of the group. Resolved to user IDs on every apply.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"exclusive": schema.BoolAttribute{
+				Description: "Whether members that are not listed in user_ids or emails are removed from the group. Otherwise they are left alone.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *GroupMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Groups
+	r.usersClient = clients.Users
+}
+
+func (r *GroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan GroupMembershipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, &plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.GroupID
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *GroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state GroupMembershipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.client.Get(ctx, state.GroupID.ValueString())
+	if err != nil {
+		if errors.Is(err, apierror.ErrNotFound) {
+			// The membership goes away with the group
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading group",
+			fmt.Sprintf("Could not read group with ID %s: %s", state.GroupID.ValueString(), err),
+		)
+		return
+	}
+
+	members := make(map[string]bool, len(group.UserIDs))
+	for _, id := range group.UserIDs {
+		members[id] = true
+	}
+
+	var userIDs, emails []string
+	resp.Diagnostics.Append(state.UserIDs.ElementsAs(ctx, &userIDs, false)...)
+	resp.Diagnostics.Append(state.Emails.ElementsAs(ctx, &emails, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only report managed members that are still in the group, so removed members show up as drift
+	managed := make(map[string]bool)
+	var presentUserIDs []string
+	for _, id := range userIDs {
+		managed[id] = true
+		if members[id] {
+			presentUserIDs = append(presentUserIDs, id)
+		}
+	}
+
+	var presentEmails []string
+	if len(emails) > 0 {
+		emailIDs, err := userIDsByEmail(ctx, r.usersClient)
+		if err != nil {
+			resp.Diagnostics.AddError("Error resolving member emails", err.Error())
+			return
+		}
+		for _, email := range emails {
+			id, ok := emailIDs[strings.ToLower(email)]
+			if !ok {
+				continue
+			}
+			managed[id] = true
+			if members[id] {
+				presentEmails = append(presentEmails, email)
+			}
+		}
+	}
+
+	// In exclusive mode, members added outside of Terraform show up as drift in user_ids
+	if state.Exclusive.ValueBool() {
+		for _, id := range group.UserIDs {
+			if !managed[id] {
+				presentUserIDs = append(presentUserIDs, id)
+			}
+		}
+	}
+
+	if !state.UserIDs.IsNull() || len(presentUserIDs) > 0 {
+		state.UserIDs, diags = types.SetValueFrom(ctx, types.StringType, append([]string{}, presentUserIDs...))
+		resp.Diagnostics.Append(diags...)
+	}
+	if !state.Emails.IsNull() {
+		state.Emails, diags = types.SetValueFrom(ctx, types.StringType, append([]string{}, presentEmails...))
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *GroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state GroupMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, &plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.GroupID
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *GroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state GroupMembershipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed, err := r.memberIDs(ctx, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Error resolving group members", err.Error())
+		return
+	}
+
+	group, err := r.client.Get(ctx, state.GroupID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading group",
+			fmt.Sprintf("Could not read group with ID %s: %s", state.GroupID.ValueString(), err),
+		)
+		return
+	}
+
+	remaining := []string{}
+	for _, id := range group.UserIDs {
+		if !managed[id] {
+			remaining = append(remaining, id)
+		}
+	}
+
+	if _, err := r.client.UpdateUserIDs(ctx, state.GroupID.ValueString(), remaining); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating group members",
+			fmt.Sprintf("Could not remove members from group with ID %s: %s", state.GroupID.ValueString(), err),
+		)
+		return
+	}
+}
+
+func (r *GroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("exclusive"), true)...)
+}
+
+// reconcile updates the group so it contains the planned members. Members
+// managed by the prior state that are no longer planned are removed; other
+// members are only removed in exclusive mode.
+func (r *GroupMembershipResource) reconcile(ctx context.Context, plan, prior *GroupMembershipResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	desired, err := r.memberIDs(ctx, plan)
+	if err != nil {
+		diags.AddError("Error resolving group members", err.Error())
+		return diags
+	}
+
+	previous := map[string]bool{}
+	if prior != nil {
+		previous, err = r.memberIDs(ctx, prior)
+		if err != nil {
+			diags.AddError("Error resolving group members", err.Error())
+			return diags
+		}
+	}
+
+	group, err := r.client.Get(ctx, plan.GroupID.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error reading group",
+			fmt.Sprintf("Could not read group with ID %s: %s", plan.GroupID.ValueString(), err),
+		)
+		return diags
+	}
+
+	members := map[string]bool{}
+	if !plan.Exclusive.ValueBool() {
+		for _, id := range group.UserIDs {
+			if !previous[id] {
+				members[id] = true
+			}
+		}
+	}
+	for id := range desired {
+		members[id] = true
+	}
+
+	userIDs := make([]string, 0, len(members))
+	for id := range members {
+		userIDs = append(userIDs, id)
+	}
+	sort.Strings(userIDs)
+
+	if _, err := r.client.UpdateUserIDs(ctx, plan.GroupID.ValueString(), userIDs); err != nil {
+		diags.AddError(
+			"Error updating group members",
+			fmt.Sprintf("Could not update members of group with ID %s: %s", plan.GroupID.ValueString(), err),
+		)
+		return diags
+	}
+
+	return diags
+}
+
+// memberIDs returns the IDs of all users listed in user_ids or emails.
+func (r *GroupMembershipResource) memberIDs(ctx context.Context, data *GroupMembershipResourceModel) (map[string]bool, error) {
+	var userIDs, emails []string
+	if diags := data.UserIDs.ElementsAs(ctx, &userIDs, false); diags.HasError() {
+		return nil, fmt.Errorf("invalid user_ids: %v", diags)
+	}
+	if diags := data.Emails.ElementsAs(ctx, &emails, false); diags.HasError() {
+		return nil, fmt.Errorf("invalid emails: %v", diags)
+	}
+
+	ids := make(map[string]bool, len(userIDs)+len(emails))
+	for _, id := range userIDs {
+		ids[id] = true
+	}
+
+	if len(emails) == 0 {
+		return ids, nil
+	}
+
+	emailIDs, err := userIDsByEmail(ctx, r.usersClient)
+	if err != nil {
+		return nil, err
+	}
+	for _, email := range emails {
+		id, ok := emailIDs[strings.ToLower(email)]
+		if !ok {
+			return nil, fmt.Errorf("no user found with email %s", email)
+		}
+		ids[id] = true
+	}
+
+	return ids, nil
+}
+
+// userIDsByEmail maps the lowercased emails of all known users to their IDs.
+func userIDsByEmail(ctx context.Context, client *users.Client) (map[string]string, error) {
+	allUsers, err := client.GetUsers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not list users: %v", err)
+	}
+
+	emailIDs := make(map[string]string, len(allUsers))
+	for _, user := range allUsers {
+		emailIDs[strings.ToLower(user.Email.ValueString())] = user.ID.ValueString()
+	}
+
+	return emailIDs, nil
+}
@@ -0,0 +1,315 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/groups"
+)
+
+// groupPermissionsModel describes the permissions attribute shared by the group resource and data source.
+type groupPermissionsModel struct {
+	Workspace workspacePermissionsModel `tfsdk:"workspace"`
+	Chat      chatPermissionsModel      `tfsdk:"chat"`
+	Sharing   *sharingPermissionsModel  `tfsdk:"sharing"`
+	Features  *featurePermissionsModel  `tfsdk:"features"`
+}
+
+type workspacePermissionsModel struct {
+	Models    types.Bool `tfsdk:"models"`
+	Knowledge types.Bool `tfsdk:"knowledge"`
+	Prompts   types.Bool `tfsdk:"prompts"`
+	Tools     types.Bool `tfsdk:"tools"`
+}
+
+type chatPermissionsModel struct {
+	FileUpload        types.Bool `tfsdk:"file_upload"`
+	Delete            types.Bool `tfsdk:"delete"`
+	Edit              types.Bool `tfsdk:"edit"`
+	Temporary         types.Bool `tfsdk:"temporary"`
+	Controls          types.Bool `tfsdk:"controls"`
+	STT               types.Bool `tfsdk:"stt"`
+	TTS               types.Bool `tfsdk:"tts"`
+	Call              types.Bool `tfsdk:"call"`
+	MultipleModels    types.Bool `tfsdk:"multiple_models"`
+	TemporaryEnforced types.Bool `tfsdk:"temporary_enforced"`
+}
+
+type sharingPermissionsModel struct {
+	PublicModels    types.Bool `tfsdk:"public_models"`
+	PublicKnowledge types.Bool `tfsdk:"public_knowledge"`
+	PublicPrompts   types.Bool `tfsdk:"public_prompts"`
+	PublicTools     types.Bool `tfsdk:"public_tools"`
+}
+
+type featurePermissionsModel struct {
+	DirectToolServers types.Bool `tfsdk:"direct_tool_servers"`
+	WebSearch         types.Bool `tfsdk:"web_search"`
+	ImageGeneration   types.Bool `tfsdk:"image_generation"`
+	CodeInterpreter   types.Bool `tfsdk:"code_interpreter"`
+}
+
+// Descriptions of the individual permissions, shared by the resource and data source schemas.
+var (
+	workspacePermissionDescriptions = map[string]string{
+		"models":    "Whether members can create and edit models in the workspace.",
+		"knowledge": "Whether members can create and edit knowledge bases in the workspace.",
+		"prompts":   "Whether members can create and edit prompts in the workspace.",
+		"tools":     "Whether members can create and edit tools in the workspace.",
+	}
+	requiredChatPermissionDescriptions = map[string]string{
+		"file_upload": "Whether members can upload files in chats.",
+		"delete":      "Whether members can delete chats.",
+		"edit":        "Whether members can edit chat messages.",
+		"temporary":   "Whether members can start temporary chats.",
+	}
+	optionalChatPermissionDescriptions = map[string]string{
+		"controls":           "Whether members can change chat controls such as the system prompt and parameters.",
+		"stt":                "Whether members can use speech to text.",
+		"tts":                "Whether members can use text to speech.",
+		"call":               "Whether members can use voice calls.",
+		"multiple_models":    "Whether members can chat with multiple models at once.",
+		"temporary_enforced": "Whether all chats of members are temporary.",
+	}
+	sharingPermissionDescriptions = map[string]string{
+		"public_models":    "Whether members can make models public.",
+		"public_knowledge": "Whether members can make knowledge bases public.",
+		"public_prompts":   "Whether members can make prompts public.",
+		"public_tools":     "Whether members can make tools public.",
+	}
+	featurePermissionDescriptions = map[string]string{
+		"direct_tool_servers": "Whether members can connect their own tool servers.",
+		"web_search":          "Whether members can use web search.",
+		"image_generation":    "Whether members can generate images.",
+		"code_interpreter":    "Whether members can use the code interpreter.",
+	}
+)
+
+// groupPermissionsAttrTypes returns the attribute types of the permissions attribute.
+func groupPermissionsAttrTypes() map[string]attr.Type {
+	boolTypes := func(descriptions ...map[string]string) types.ObjectType {
+		attrTypes := map[string]attr.Type{}
+		for _, d := range descriptions {
+			for name := range d {
+				attrTypes[name] = types.BoolType
+			}
+		}
+		return types.ObjectType{AttrTypes: attrTypes}
+	}
+
+	return map[string]attr.Type{
+		"workspace": boolTypes(workspacePermissionDescriptions),
+		"chat":      boolTypes(requiredChatPermissionDescriptions, optionalChatPermissionDescriptions),
+		"sharing":   boolTypes(sharingPermissionDescriptions),
+		"features":  boolTypes(featurePermissionDescriptions),
+	}
+}
+
+// groupPermissionsResourceAttribute returns the permissions attribute of the group resource.
+// Optional permissions that are left unset fall back to the default user permissions of the instance.
+func groupPermissionsResourceAttribute() schema.SingleNestedAttribute {
+	boolAttributes := func(required bool, descriptions map[string]string) map[string]schema.Attribute {
+		attributes := make(map[string]schema.Attribute, len(descriptions))
+		for name, description := range descriptions {
+			attributes[name] = schema.BoolAttribute{
+				Description: description,
+				Required:    required,
+				Optional:    !required,
+			}
+		}
+		return attributes
+	}
+
+	chat := boolAttributes(true, requiredChatPermissionDescriptions)
+	for name, attribute := range boolAttributes(false, optionalChatPermissionDescriptions) {
+		chat[name] = attribute
+	}
+
+	return schema.SingleNestedAttribute{
+		Description: "Permissions for the group. Optional permissions that are not set fall back to the default user permissions. " +
+			"If the whole attribute is not set, the permissions on the server are left untouched.",
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.SingleNestedAttribute{
+				Description: "Workspace permissions.",
+				Required:    true,
+				Attributes:  boolAttributes(true, workspacePermissionDescriptions),
+			},
+			"chat": schema.SingleNestedAttribute{
+				Description: "Chat permissions.",
+				Required:    true,
+				Attributes:  chat,
+			},
+			"sharing": schema.SingleNestedAttribute{
+				Description: "Sharing permissions.",
+				Optional:    true,
+				Attributes:  boolAttributes(false, sharingPermissionDescriptions),
+			},
+			"features": schema.SingleNestedAttribute{
+				Description: "Feature permissions.",
+				Optional:    true,
+				Attributes:  boolAttributes(false, featurePermissionDescriptions),
+			},
+		},
+	}
+}
+
+// groupPermissionsDataSourceAttribute returns the permissions attribute of the group data source.
+func groupPermissionsDataSourceAttribute() datasourceschema.SingleNestedAttribute {
+	boolAttributes := func(descriptions ...map[string]string) map[string]datasourceschema.Attribute {
+		attributes := map[string]datasourceschema.Attribute{}
+		for _, d := range descriptions {
+			for name, description := range d {
+				attributes[name] = datasourceschema.BoolAttribute{
+					Computed:            true,
+					MarkdownDescription: description,
+				}
+			}
+		}
+		return attributes
+	}
+
+	return datasourceschema.SingleNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: "Permissions for the group",
+		Attributes: map[string]datasourceschema.Attribute{
+			"workspace": datasourceschema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Workspace permissions",
+				Attributes:          boolAttributes(workspacePermissionDescriptions),
+			},
+			"chat": datasourceschema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Chat permissions",
+				Attributes:          boolAttributes(requiredChatPermissionDescriptions, optionalChatPermissionDescriptions),
+			},
+			"sharing": datasourceschema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Sharing permissions",
+				Attributes:          boolAttributes(sharingPermissionDescriptions),
+			},
+			"features": datasourceschema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Feature permissions",
+				Attributes:          boolAttributes(featurePermissionDescriptions),
+			},
+		},
+	}
+}
+
+// groupPermissionsFromObject converts the permissions attribute into the API form. A null attribute yields nil.
+func groupPermissionsFromObject(ctx context.Context, obj types.Object) (*groups.GroupPermissions, diag.Diagnostics) {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil, nil
+	}
+
+	var model groupPermissionsModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	permissions := &groups.GroupPermissions{
+		Workspace: groups.WorkspacePermissions{
+			Models:    model.Workspace.Models.ValueBool(),
+			Knowledge: model.Workspace.Knowledge.ValueBool(),
+			Prompts:   model.Workspace.Prompts.ValueBool(),
+			Tools:     model.Workspace.Tools.ValueBool(),
+		},
+		Chat: groups.ChatPermissions{
+			FileUpload:        model.Chat.FileUpload.ValueBool(),
+			Delete:            model.Chat.Delete.ValueBool(),
+			Edit:              model.Chat.Edit.ValueBool(),
+			Temporary:         model.Chat.Temporary.ValueBool(),
+			Controls:          model.Chat.Controls.ValueBoolPointer(),
+			STT:               model.Chat.STT.ValueBoolPointer(),
+			TTS:               model.Chat.TTS.ValueBoolPointer(),
+			Call:              model.Chat.Call.ValueBoolPointer(),
+			MultipleModels:    model.Chat.MultipleModels.ValueBoolPointer(),
+			TemporaryEnforced: model.Chat.TemporaryEnforced.ValueBoolPointer(),
+		},
+	}
+
+	if model.Sharing != nil {
+		permissions.Sharing = &groups.SharingPermissions{
+			PublicModels:    model.Sharing.PublicModels.ValueBoolPointer(),
+			PublicKnowledge: model.Sharing.PublicKnowledge.ValueBoolPointer(),
+			PublicPrompts:   model.Sharing.PublicPrompts.ValueBoolPointer(),
+			PublicTools:     model.Sharing.PublicTools.ValueBoolPointer(),
+		}
+	}
+
+	if model.Features != nil {
+		permissions.Features = &groups.FeaturePermissions{
+			DirectToolServers: model.Features.DirectToolServers.ValueBoolPointer(),
+			WebSearch:         model.Features.WebSearch.ValueBoolPointer(),
+			ImageGeneration:   model.Features.ImageGeneration.ValueBoolPointer(),
+			CodeInterpreter:   model.Features.CodeInterpreter.ValueBoolPointer(),
+		}
+	}
+
+	return permissions, diags
+}
+
+// groupPermissionsObject converts the API form of the permissions into the permissions attribute. Nil yields null.
+func groupPermissionsObject(ctx context.Context, permissions *groups.GroupPermissions) (types.Object, diag.Diagnostics) {
+	if permissions == nil {
+		return types.ObjectNull(groupPermissionsAttrTypes()), nil
+	}
+
+	model := groupPermissionsModel{
+		Workspace: workspacePermissionsModel{
+			Models:    types.BoolValue(permissions.Workspace.Models),
+			Knowledge: types.BoolValue(permissions.Workspace.Knowledge),
+			Prompts:   types.BoolValue(permissions.Workspace.Prompts),
+			Tools:     types.BoolValue(permissions.Workspace.Tools),
+		},
+		Chat: chatPermissionsModel{
+			FileUpload:        types.BoolValue(permissions.Chat.FileUpload),
+			Delete:            types.BoolValue(permissions.Chat.Delete),
+			Edit:              types.BoolValue(permissions.Chat.Edit),
+			Temporary:         types.BoolValue(permissions.Chat.Temporary),
+			Controls:          types.BoolPointerValue(permissions.Chat.Controls),
+			STT:               types.BoolPointerValue(permissions.Chat.STT),
+			TTS:               types.BoolPointerValue(permissions.Chat.TTS),
+			Call:              types.BoolPointerValue(permissions.Chat.Call),
+			MultipleModels:    types.BoolPointerValue(permissions.Chat.MultipleModels),
+			TemporaryEnforced: types.BoolPointerValue(permissions.Chat.TemporaryEnforced),
+		},
+	}
+
+	if permissions.Sharing != nil {
+		model.Sharing = &sharingPermissionsModel{
+			PublicModels:    types.BoolPointerValue(permissions.Sharing.PublicModels),
+			PublicKnowledge: types.BoolPointerValue(permissions.Sharing.PublicKnowledge),
+			PublicPrompts:   types.BoolPointerValue(permissions.Sharing.PublicPrompts),
+			PublicTools:     types.BoolPointerValue(permissions.Sharing.PublicTools),
+		}
+	}
+
+	if permissions.Features != nil {
+		model.Features = &featurePermissionsModel{
+			DirectToolServers: types.BoolPointerValue(permissions.Features.DirectToolServers),
+			WebSearch:         types.BoolPointerValue(permissions.Features.WebSearch),
+			ImageGeneration:   types.BoolPointerValue(permissions.Features.ImageGeneration),
+			CodeInterpreter:   types.BoolPointerValue(permissions.Features.CodeInterpreter),
+		}
+	}
+
+	return types.ObjectValueFrom(ctx, groupPermissionsAttrTypes(), model)
+}
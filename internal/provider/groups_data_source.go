@@ -7,12 +7,11 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	"github.com/coalition-sre/terraform-provider-openwebui/internal/provider/client/groups"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/groups"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
@@ -44,7 +43,7 @@ func (d *GroupDataSource) Metadata(_ context.Context, req datasource.MetadataReq
 
 func (d *GroupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Group data source for OpenWebUI",
+		MarkdownDescription: "Group data source for OpenWebUI. Looks up a group by its name, e.g. to reference its ID in access control settings",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -64,30 +63,7 @@ func (d *GroupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Computed:            true,
 				MarkdownDescription: "List of user IDs in the group",
 			},
-			"permissions": schema.SingleNestedAttribute{
-				Computed:            true,
-				MarkdownDescription: "Permissions for the group",
-				Attributes: map[string]schema.Attribute{
-					"workspace": schema.SingleNestedAttribute{
-						Computed: true,
-						Attributes: map[string]schema.Attribute{
-							"models":    schema.BoolAttribute{Computed: true},
-							"knowledge": schema.BoolAttribute{Computed: true},
-							"prompts":   schema.BoolAttribute{Computed: true},
-							"tools":     schema.BoolAttribute{Computed: true},
-						},
-					},
-					"chat": schema.SingleNestedAttribute{
-						Computed: true,
-						Attributes: map[string]schema.Attribute{
-							"file_upload": schema.BoolAttribute{Computed: true},
-							"delete":      schema.BoolAttribute{Computed: true},
-							"edit":        schema.BoolAttribute{Computed: true},
-							"temporary":   schema.BoolAttribute{Computed: true},
-						},
-					},
-				},
-			},
+			"permissions": groupPermissionsDataSourceAttribute(),
 			"created_at": schema.Int64Attribute{
 				Computed:            true,
 				MarkdownDescription: "Timestamp when the group was created",
@@ -105,25 +81,16 @@ func (d *GroupDataSource) Configure(_ context.Context, req datasource.ConfigureR
 		return
 	}
 
-	clients, ok := req.ProviderData.(map[string]interface{})
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
-	}
-
-	client, ok := clients["groups"].(*groups.Client)
+	clients, ok := req.ProviderData.(*ProviderClients)
 	if !ok {
 		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *groups.Client, got: %T. Please report this issue to the provider developers.", clients["groups"]),
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = clients.Groups
 }
 
 func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -136,98 +103,21 @@ func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Get groups from API
-	groups, err := d.client.List()
+	groupList, err := d.client.List(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read groups, got error: %s", err))
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read groups, got error: %s", err))
 		return
 	}
 
 	// Find the group with matching name
-	var found bool
-	for _, group := range groups {
+	var matches []groups.Group
+	for _, group := range groupList {
 		if group.Name == data.Name.ValueString() {
-			// Convert API response to model
-			data.ID = types.StringValue(group.ID)
-			data.Description = types.StringValue(group.Description)
-			data.CreatedAt = types.Int64Value(group.CreatedAt)
-			data.UpdatedAt = types.Int64Value(group.UpdatedAt)
-
-			// Handle user IDs
-			userIDs, diags := types.ListValueFrom(ctx, types.StringType, group.UserIDs)
-			resp.Diagnostics.Append(diags...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			data.UserIDs = userIDs
-
-			// Handle permissions
-			if group.Permissions != nil {
-				workspaceAttrs := map[string]attr.Value{
-					"models":    types.BoolValue(group.Permissions.Workspace.Models),
-					"knowledge": types.BoolValue(group.Permissions.Workspace.Knowledge),
-					"prompts":   types.BoolValue(group.Permissions.Workspace.Prompts),
-					"tools":     types.BoolValue(group.Permissions.Workspace.Tools),
-				}
-
-				chatAttrs := map[string]attr.Value{
-					"file_upload": types.BoolValue(group.Permissions.Chat.FileUpload),
-					"delete":      types.BoolValue(group.Permissions.Chat.Delete),
-					"edit":        types.BoolValue(group.Permissions.Chat.Edit),
-					"temporary":   types.BoolValue(group.Permissions.Chat.Temporary),
-				}
-
-				workspaceObj, diags := types.ObjectValue(
-					map[string]attr.Type{
-						"models":    types.BoolType,
-						"knowledge": types.BoolType,
-						"prompts":   types.BoolType,
-						"tools":     types.BoolType,
-					},
-					workspaceAttrs,
-				)
-				resp.Diagnostics.Append(diags...)
-				if resp.Diagnostics.HasError() {
-					return
-				}
-
-				chatObj, diags := types.ObjectValue(
-					map[string]attr.Type{
-						"file_upload": types.BoolType,
-						"delete":      types.BoolType,
-						"edit":        types.BoolType,
-						"temporary":   types.BoolType,
-					},
-					chatAttrs,
-				)
-				resp.Diagnostics.Append(diags...)
-				if resp.Diagnostics.HasError() {
-					return
-				}
-
-				permissionsObj, diags := types.ObjectValue(
-					map[string]attr.Type{
-						"workspace": types.ObjectType{AttrTypes: workspaceObj.AttributeTypes(ctx)},
-						"chat":      types.ObjectType{AttrTypes: chatObj.AttributeTypes(ctx)},
-					},
-					map[string]attr.Value{
-						"workspace": workspaceObj,
-						"chat":      chatObj,
-					},
-				)
-				resp.Diagnostics.Append(diags...)
-				if resp.Diagnostics.HasError() {
-					return
-				}
-
-				data.Permissions = permissionsObj
-			}
-
-			found = true
-			break
+			matches = append(matches, group)
 		}
 	}
 
-	if !found {
+	if len(matches) == 0 {
 		resp.Diagnostics.AddError(
 			"Group Not Found",
 			fmt.Sprintf("No group found with name: %s", data.Name.ValueString()),
@@ -235,6 +125,38 @@ func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	if len(matches) > 1 {
+		resp.Diagnostics.AddError(
+			"Multiple Groups Found",
+			fmt.Sprintf("Found %d groups with name %s. Group names must be unique to be looked up by name.", len(matches), data.Name.ValueString()),
+		)
+		return
+	}
+
+	group := matches[0]
+
+	// Convert API response to model
+	data.ID = types.StringValue(group.ID)
+	data.Description = types.StringValue(group.Description)
+	data.CreatedAt = types.Int64Value(group.CreatedAt)
+	data.UpdatedAt = types.Int64Value(group.UpdatedAt)
+
+	// Handle user IDs
+	userIDs, diags := types.ListValueFrom(ctx, types.StringType, append([]string{}, group.UserIDs...))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.UserIDs = userIDs
+
+	// Handle permissions
+	permissions, diags := groupPermissionsObject(ctx, group.Permissions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Permissions = permissions
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
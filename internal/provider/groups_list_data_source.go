@@ -0,0 +1,137 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/groups"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &GroupsDataSource{}
+
+func NewGroupsDataSource() datasource.DataSource {
+	return &GroupsDataSource{}
+}
+
+// GroupsDataSource defines the data source implementation.
+type GroupsDataSource struct {
+	client *groups.Client
+}
+
+// GroupsDataSourceModel describes the data source data model.
+type GroupsDataSourceModel struct {
+	Groups []GroupEntryModel `tfsdk:"groups"`
+}
+
+// GroupEntryModel describes a single group in the list.
+type GroupEntryModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	UserIDs     types.List   `tfsdk:"user_ids"`
+	CreatedAt   types.Int64  `tfsdk:"created_at"`
+	UpdatedAt   types.Int64  `tfsdk:"updated_at"`
+}
+
+func (d *GroupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_groups"
+}
+
+func (d *GroupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists all groups of the OpenWebUI instance, e.g. to build a map of group names to IDs",
+
+		Attributes: map[string]schema.Attribute{
+			"groups": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "All groups, in the order returned by the server",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Group identifier",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the group",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Description of the group",
+						},
+						"user_ids": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "List of user IDs in the group",
+						},
+						"created_at": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Timestamp when the group was created",
+						},
+						"updated_at": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Timestamp when the group was last updated",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GroupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = clients.Groups
+}
+
+func (d *GroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GroupsDataSourceModel
+
+	// Get groups from API
+	groupList, err := d.client.List(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read groups, got error: %s", err))
+		return
+	}
+
+	data.Groups = make([]GroupEntryModel, 0, len(groupList))
+	for _, group := range groupList {
+		userIDs, diags := types.ListValueFrom(ctx, types.StringType, append([]string{}, group.UserIDs...))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Groups = append(data.Groups, GroupEntryModel{
+			ID:          types.StringValue(group.ID),
+			Name:        types.StringValue(group.Name),
+			Description: types.StringValue(group.Description),
+			UserIDs:     userIDs,
+			CreatedAt:   types.Int64Value(group.CreatedAt),
+			UpdatedAt:   types.Int64Value(group.UpdatedAt),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
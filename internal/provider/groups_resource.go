@@ -5,35 +5,44 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
-	"github.com/coalition-sre/terraform-provider-openwebui/internal/provider/client/groups"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/groups"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/users"
 )
 
 var (
 	_ resource.Resource                = &GroupResource{}
 	_ resource.ResourceWithImportState = &GroupResource{}
+	_ resource.ResourceWithModifyPlan  = &GroupResource{}
 )
 
 type GroupResource struct {
-	client *groups.Client
+	client      *groups.Client
+	usersClient *users.Client
 }
 
 type GroupResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	UserIDs     types.List   `tfsdk:"user_ids"`
-	Permissions types.Object `tfsdk:"permissions"`
+	ID                           types.String `tfsdk:"id"`
+	Name                         types.String `tfsdk:"name"`
+	Description                  types.String `tfsdk:"description"`
+	UserIDs                      types.List   `tfsdk:"user_ids"`
+	MemberEmails                 types.Set    `tfsdk:"member_emails"`
+	IgnoreExternallyAddedMembers types.Bool   `tfsdk:"ignore_externally_added_members"`
+	Permissions                  types.Object `tfsdk:"permissions"`
 }
 
 func NewGroupResource() resource.Resource {
@@ -68,30 +77,19 @@ func (r *GroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Optional:    true,
 				ElementType: types.StringType,
 			},
-			"permissions": schema.SingleNestedAttribute{
-				Description: "Permissions for the group.",
+			"member_emails": schema.SetAttribute{
+				Description: "Email addresses of users in the group, resolved to user IDs when planning and applying. Combined with `user_ids`; do not list the same user in both.",
 				Optional:    true,
-				Attributes: map[string]schema.Attribute{
-					"workspace": schema.SingleNestedAttribute{
-						Required: true,
-						Attributes: map[string]schema.Attribute{
-							"models":    schema.BoolAttribute{Required: true},
-							"knowledge": schema.BoolAttribute{Required: true},
-							"prompts":   schema.BoolAttribute{Required: true},
-							"tools":     schema.BoolAttribute{Required: true},
-						},
-					},
-					"chat": schema.SingleNestedAttribute{
-						Required: true,
-						Attributes: map[string]schema.Attribute{
-							"file_upload": schema.BoolAttribute{Required: true},
-							"delete":      schema.BoolAttribute{Required: true},
-							"edit":        schema.BoolAttribute{Required: true},
-							"temporary":   schema.BoolAttribute{Required: true},
-						},
-					},
-				},
+				ElementType: types.StringType,
+			},
+			"ignore_externally_added_members": schema.BoolAttribute{
+				Description: "Whether members added outside of Terraform, e.g. by SCIM or OAuth group sync, are left in the group and ignored. " +
+					"Terraform then only ensures that the members in `user_ids` and `member_emails` are present, and only removes members it added itself.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
 			},
+			"permissions": groupPermissionsResourceAttribute(),
 		},
 	}
 }
@@ -101,25 +99,39 @@ func (r *GroupResource) Configure(_ context.Context, req resource.ConfigureReque
 		return
 	}
 
-	clients, ok := req.ProviderData.(map[string]interface{})
+	clients, ok := req.ProviderData.(*ProviderClients)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	client, ok := clients["groups"].(*groups.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *groups.Client, got: %T. Please report this issue to the provider developers.", clients["groups"]),
-		)
+	r.client = clients.Groups
+	r.usersClient = clients.Users
+}
+
+// ModifyPlan resolves member_emails, so unknown emails fail the plan instead of the apply.
+func (r *GroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.usersClient == nil {
 		return
 	}
 
-	r.client = client
+	var plan GroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.MemberEmails.IsNull() || plan.MemberEmails.IsUnknown() {
+		return
+	}
+	for _, email := range plan.MemberEmails.Elements() {
+		if email.IsUnknown() {
+			// Resolved on apply once all emails are known
+			return
+		}
+	}
+
+	_, diags := r.resolveMemberEmails(ctx, plan.MemberEmails)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -130,30 +142,15 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	// First, create the group with basic information
-	createGroup := &groups.Group{
-		Name:        plan.Name.ValueString(),
-		Description: plan.Description.ValueString(),
-	}
-
-	createdGroup, err := r.client.Create(createGroup)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating group",
-			fmt.Sprintf("Could not create group: %s", err),
-		)
-		return
-	}
-
-	// Now prepare the update with all the additional information
+	// Prepare the update with all the additional information before creating
+	// the group, so invalid configuration cannot leave an orphaned group behind
 	updateGroup := &groups.Group{
 		Name:        plan.Name.ValueString(),
 		Description: plan.Description.ValueString(),
 	}
 
 	// Handle user IDs
-	var userIDs []string
-	diags = plan.UserIDs.ElementsAs(ctx, &userIDs, false)
+	userIDs, diags := r.memberIDs(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -161,55 +158,50 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 	updateGroup.UserIDs = userIDs
 
 	// Handle permissions
-	if !plan.Permissions.IsNull() {
-		var permissions struct {
-			Workspace struct {
-				Models    bool `tfsdk:"models"`
-				Knowledge bool `tfsdk:"knowledge"`
-				Prompts   bool `tfsdk:"prompts"`
-				Tools     bool `tfsdk:"tools"`
-			} `tfsdk:"workspace"`
-			Chat struct {
-				FileUpload bool `tfsdk:"file_upload"`
-				Delete     bool `tfsdk:"delete"`
-				Edit       bool `tfsdk:"edit"`
-				Temporary  bool `tfsdk:"temporary"`
-			} `tfsdk:"chat"`
-		}
-		diags = plan.Permissions.As(ctx, &permissions, basetypes.ObjectAsOptions{})
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+	permissions, diags := groupPermissionsFromObject(ctx, plan.Permissions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updateGroup.Permissions = permissions
 
-		updateGroup.Permissions = &groups.GroupPermissions{
-			Workspace: groups.WorkspacePermissions{
-				Models:    permissions.Workspace.Models,
-				Knowledge: permissions.Workspace.Knowledge,
-				Prompts:   permissions.Workspace.Prompts,
-				Tools:     permissions.Workspace.Tools,
-			},
-			Chat: groups.ChatPermissions{
-				FileUpload: permissions.Chat.FileUpload,
-				Delete:     permissions.Chat.Delete,
-				Edit:       permissions.Chat.Edit,
-				Temporary:  permissions.Chat.Temporary,
-			},
-		}
+	// Create the group with basic information
+	createGroup := &groups.Group{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+	}
+
+	createdGroup, err := r.client.Create(ctx, createGroup)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating group",
+			fmt.Sprintf("Could not create group: %s", err),
+		)
+		return
 	}
 
 	// Update the group with all the information
-	updatedGroup, err := r.client.Update(createdGroup.ID, updateGroup)
+	updatedGroup, err := r.client.Update(ctx, createdGroup.ID, updateGroup)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating group",
 			fmt.Sprintf("Could not update group with ID %s: %s", createdGroup.ID, err),
 		)
+		r.rollbackCreate(ctx, createdGroup.ID, &plan, resp)
 		return
 	}
 
 	plan.ID = types.StringValue(updatedGroup.ID)
 
+	// Permissions that are not configured are left untouched and reported as the server has them
+	if plan.Permissions.IsUnknown() {
+		plan.Permissions, diags = groupPermissionsObject(ctx, updatedGroup.Permissions)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 }
@@ -222,8 +214,13 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	group, err := r.client.Get(state.ID.ValueString())
+	group, err := r.client.Get(ctx, state.ID.ValueString())
 	if err != nil {
+		if errors.Is(err, apierror.ErrNotFound) {
+			// Deleted outside of Terraform, plan to create it again
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error reading group",
 			fmt.Sprintf("Could not read group ID %s: %s", state.ID.ValueString(), err),
@@ -234,82 +231,27 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	state.Name = types.StringValue(group.Name)
 	state.Description = types.StringValue(group.Description)
 
-	userIDs, diags := types.ListValueFrom(ctx, types.StringType, group.UserIDs)
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(r.setMembersState(ctx, group.UserIDs, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	state.UserIDs = userIDs
 
-	if group.Permissions != nil {
-		workspaceAttrs := map[string]attr.Value{
-			"models":    types.BoolValue(group.Permissions.Workspace.Models),
-			"knowledge": types.BoolValue(group.Permissions.Workspace.Knowledge),
-			"prompts":   types.BoolValue(group.Permissions.Workspace.Prompts),
-			"tools":     types.BoolValue(group.Permissions.Workspace.Tools),
-		}
-
-		chatAttrs := map[string]attr.Value{
-			"file_upload": types.BoolValue(group.Permissions.Chat.FileUpload),
-			"delete":      types.BoolValue(group.Permissions.Chat.Delete),
-			"edit":        types.BoolValue(group.Permissions.Chat.Edit),
-			"temporary":   types.BoolValue(group.Permissions.Chat.Temporary),
-		}
-
-		workspaceObj, diags := types.ObjectValue(
-			map[string]attr.Type{
-				"models":    types.BoolType,
-				"knowledge": types.BoolType,
-				"prompts":   types.BoolType,
-				"tools":     types.BoolType,
-			},
-			workspaceAttrs,
-		)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		chatObj, diags := types.ObjectValue(
-			map[string]attr.Type{
-				"file_upload": types.BoolType,
-				"delete":      types.BoolType,
-				"edit":        types.BoolType,
-				"temporary":   types.BoolType,
-			},
-			chatAttrs,
-		)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		permissionsObj, diags := types.ObjectValue(
-			map[string]attr.Type{
-				"workspace": types.ObjectType{AttrTypes: workspaceObj.AttributeTypes(ctx)},
-				"chat":      types.ObjectType{AttrTypes: chatObj.AttributeTypes(ctx)},
-			},
-			map[string]attr.Value{
-				"workspace": workspaceObj,
-				"chat":      chatObj,
-			},
-		)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		state.Permissions = permissionsObj
+	permissions, diags := groupPermissionsObject(ctx, group.Permissions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	state.Permissions = permissions
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan GroupResourceModel
+	var plan, state GroupResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -319,52 +261,29 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		Description: plan.Description.ValueString(),
 	}
 
-	var userIDs []string
-	diags = plan.UserIDs.ElementsAs(ctx, &userIDs, false)
+	userIDs, diags := r.memberIDs(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	group.UserIDs = userIDs
-
-	if !plan.Permissions.IsNull() {
-		var permissions struct {
-			Workspace struct {
-				Models    bool `tfsdk:"models"`
-				Knowledge bool `tfsdk:"knowledge"`
-				Prompts   bool `tfsdk:"prompts"`
-				Tools     bool `tfsdk:"tools"`
-			} `tfsdk:"workspace"`
-			Chat struct {
-				FileUpload bool `tfsdk:"file_upload"`
-				Delete     bool `tfsdk:"delete"`
-				Edit       bool `tfsdk:"edit"`
-				Temporary  bool `tfsdk:"temporary"`
-			} `tfsdk:"chat"`
-		}
-		diags = plan.Permissions.As(ctx, &permissions, basetypes.ObjectAsOptions{})
+	if plan.IgnoreExternallyAddedMembers.ValueBool() {
+		external, diags := r.externalMembers(ctx, plan.ID.ValueString(), &state)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
+		userIDs = appendMissing(userIDs, external)
+	}
+	group.UserIDs = userIDs
 
-		group.Permissions = &groups.GroupPermissions{
-			Workspace: groups.WorkspacePermissions{
-				Models:    permissions.Workspace.Models,
-				Knowledge: permissions.Workspace.Knowledge,
-				Prompts:   permissions.Workspace.Prompts,
-				Tools:     permissions.Workspace.Tools,
-			},
-			Chat: groups.ChatPermissions{
-				FileUpload: permissions.Chat.FileUpload,
-				Delete:     permissions.Chat.Delete,
-				Edit:       permissions.Chat.Edit,
-				Temporary:  permissions.Chat.Temporary,
-			},
-		}
+	permissions, diags := groupPermissionsFromObject(ctx, plan.Permissions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	group.Permissions = permissions
 
-	updatedGroup, err := r.client.Update(plan.ID.ValueString(), group)
+	updatedGroup, err := r.client.Update(ctx, plan.ID.ValueString(), group)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating group",
@@ -375,6 +294,15 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	plan.ID = types.StringValue(updatedGroup.ID)
 
+	// Permissions that are not configured are left untouched and reported as the server has them
+	if plan.Permissions.IsUnknown() {
+		plan.Permissions, diags = groupPermissionsObject(ctx, updatedGroup.Permissions)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 }
@@ -387,7 +315,7 @@ func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.client.Delete(state.ID.ValueString())
+	err := r.client.Delete(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting group",
@@ -399,4 +327,220 @@ func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ignore_externally_added_members"), false)...)
+}
+
+// rollbackCreate deletes a group whose creation failed after the group itself
+// was created. If the cleanup fails too, the group is saved to state so that
+// Terraform marks it as tainted and replaces it on the next apply instead of
+// leaking it.
+func (r *GroupResource) rollbackCreate(ctx context.Context, id string, plan *GroupResourceModel, resp *resource.CreateResponse) {
+	err := r.client.Delete(ctx, id)
+	if err == nil {
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Error cleaning up group",
+		fmt.Sprintf("Could not delete partially created group with ID %s: %s. "+
+			"The group was saved to state as tainted and will be replaced on the next apply.", id, err),
+	)
+
+	plan.ID = types.StringValue(id)
+	if plan.Permissions.IsUnknown() {
+		plan.Permissions = types.ObjectNull(groupPermissionsAttrTypes())
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// memberIDs returns the IDs of the users listed in user_ids and member_emails.
+func (r *GroupResource) memberIDs(ctx context.Context, plan *GroupResourceModel) ([]string, diag.Diagnostics) {
+	var userIDs []string
+	diags := plan.UserIDs.ElementsAs(ctx, &userIDs, false)
+	if diags.HasError() || plan.MemberEmails.IsNull() {
+		return userIDs, diags
+	}
+
+	emailIDs, d := r.resolveMemberEmails(ctx, plan.MemberEmails)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	ids := make([]string, 0, len(emailIDs))
+	for _, id := range emailIDs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return appendMissing(userIDs, ids), diags
+}
+
+// externalMembers returns the current members of the group that are not
+// listed in the prior state, i.e. the members added outside of Terraform.
+func (r *GroupResource) externalMembers(ctx context.Context, id string, state *GroupResourceModel) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	group, err := r.client.Get(ctx, id)
+	if err != nil {
+		diags.AddError(
+			"Error reading group",
+			fmt.Sprintf("Could not read group ID %s: %s", id, err),
+		)
+		return nil, diags
+	}
+
+	var userIDs, emails []string
+	diags.Append(state.UserIDs.ElementsAs(ctx, &userIDs, false)...)
+	diags.Append(state.MemberEmails.ElementsAs(ctx, &emails, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	managed := make(map[string]bool, len(userIDs)+len(emails))
+	for _, id := range userIDs {
+		managed[id] = true
+	}
+	if len(emails) > 0 {
+		emailIDs, err := userIDsByEmail(ctx, r.usersClient)
+		if err != nil {
+			diags.AddError("Error resolving member emails", err.Error())
+			return nil, diags
+		}
+		// Users deleted in the meantime are no members anymore and can be skipped
+		for _, email := range emails {
+			if id, ok := emailIDs[strings.ToLower(email)]; ok {
+				managed[id] = true
+			}
+		}
+	}
+
+	var external []string
+	for _, id := range group.UserIDs {
+		if !managed[id] {
+			external = append(external, id)
+		}
+	}
+
+	return external, diags
+}
+
+// appendMissing appends the IDs that are not yet in ids, keeping the order.
+func appendMissing(ids []string, more []string) []string {
+	seen := make(map[string]bool, len(ids)+len(more))
+	for _, id := range ids {
+		seen[id] = true
+	}
+	for _, id := range more {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// resolveMemberEmails maps the emails in member_emails to user IDs, reporting
+// every email without a user.
+func (r *GroupResource) resolveMemberEmails(ctx context.Context, memberEmails types.Set) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var emails []string
+	diags.Append(memberEmails.ElementsAs(ctx, &emails, false)...)
+	if diags.HasError() || len(emails) == 0 {
+		return nil, diags
+	}
+
+	emailIDs, err := userIDsByEmail(ctx, r.usersClient)
+	if err != nil {
+		diags.AddError("Error resolving member emails", err.Error())
+		return nil, diags
+	}
+
+	resolved := make(map[string]string, len(emails))
+	for _, email := range emails {
+		id, ok := emailIDs[strings.ToLower(email)]
+		if !ok {
+			diags.AddAttributeError(
+				path.Root("member_emails"),
+				"Unknown Member Email",
+				fmt.Sprintf("No user found with email %s. Create the user first, or remove the email from member_emails.", email),
+			)
+			continue
+		}
+		resolved[email] = id
+	}
+
+	return resolved, diags
+}
+
+// setMembersState splits the members of the group between member_emails and
+// user_ids. Listed emails whose user is no longer a member are dropped, so the
+// removal shows up as drift; all other members are reported in user_ids,
+// unless externally added members are ignored.
+func (r *GroupResource) setMembersState(ctx context.Context, members []string, state *GroupResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	byEmail := map[string]bool{}
+	if !state.MemberEmails.IsNull() {
+		var emails []string
+		diags.Append(state.MemberEmails.ElementsAs(ctx, &emails, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		isMember := make(map[string]bool, len(members))
+		for _, id := range members {
+			isMember[id] = true
+		}
+
+		presentEmails := []string{}
+		if len(emails) > 0 {
+			emailIDs, err := userIDsByEmail(ctx, r.usersClient)
+			if err != nil {
+				diags.AddError("Error resolving member emails", err.Error())
+				return diags
+			}
+			for _, email := range emails {
+				id, ok := emailIDs[strings.ToLower(email)]
+				if ok && isMember[id] {
+					byEmail[id] = true
+					presentEmails = append(presentEmails, email)
+				}
+			}
+		}
+
+		var d diag.Diagnostics
+		state.MemberEmails, d = types.SetValueFrom(ctx, types.StringType, presentEmails)
+		diags.Append(d...)
+	}
+
+	var listed map[string]bool
+	if state.IgnoreExternallyAddedMembers.ValueBool() {
+		var stateUserIDs []string
+		diags.Append(state.UserIDs.ElementsAs(ctx, &stateUserIDs, false)...)
+		if diags.HasError() {
+			return diags
+		}
+		listed = make(map[string]bool, len(stateUserIDs))
+		for _, id := range stateUserIDs {
+			listed[id] = true
+		}
+	}
+
+	var userIDs []string
+	for _, id := range members {
+		if byEmail[id] || (listed != nil && !listed[id]) {
+			continue
+		}
+		userIDs = append(userIDs, id)
+	}
+	if state.UserIDs.IsNull() && len(userIDs) == 0 {
+		return diags
+	}
+
+	var d diag.Diagnostics
+	state.UserIDs, d = types.ListValueFrom(ctx, types.StringType, userIDs)
+	diags.Append(d...)
+	return diags
 }
@@ -0,0 +1,314 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/configs"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ImageConfigResource{}
+var _ resource.ResourceWithImportState = &ImageConfigResource{}
+
+func NewImageConfigResource() resource.Resource {
+	return &ImageConfigResource{}
+}
+
+// ImageConfigResource defines the resource implementation.
+type ImageConfigResource struct {
+	client *configs.Client
+}
+
+// ImageConfigResourceModel describes the resource data model.
+type ImageConfigResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	Engine               types.String `tfsdk:"engine"`
+	PromptGeneration     types.Bool   `tfsdk:"prompt_generation"`
+	OpenAIBaseURL        types.String `tfsdk:"openai_base_url"`
+	OpenAIAPIKey         types.String `tfsdk:"openai_api_key"`
+	Automatic1111BaseURL types.String `tfsdk:"automatic1111_base_url"`
+	Automatic1111APIAuth types.String `tfsdk:"automatic1111_api_auth"`
+	ComfyUIBaseURL       types.String `tfsdk:"comfyui_base_url"`
+	ComfyUIAPIKey        types.String `tfsdk:"comfyui_api_key"`
+	ComfyUIWorkflow      types.String `tfsdk:"comfyui_workflow"`
+	GeminiBaseURL        types.String `tfsdk:"gemini_base_url"`
+	GeminiAPIKey         types.String `tfsdk:"gemini_api_key"`
+	Model                types.String `tfsdk:"model"`
+	Size                 types.String `tfsdk:"size"`
+	Steps                types.Int64  `tfsdk:"steps"`
+}
+
+func (r *ImageConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_image_config"
+}
+
+func (r *ImageConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages image generation in chats. Requires an admin token. " +
+			"OpenWebUI validates `model` against the selected engine, so the engine must be reachable when it is set. " +
+			"Settings that are not configured keep their current value. Destroying the resource leaves the settings unchanged",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Always `image`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether users can generate images from chats",
+			},
+			"engine": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Image generation engine (`openai`, `automatic1111`, `comfyui` or `gemini`)",
+				Validators: []validator.String{
+					stringvalidator.OneOf("openai", "automatic1111", "comfyui", "gemini"),
+				},
+			},
+			"prompt_generation": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether the task model rewrites the chat into an image prompt before generating",
+			},
+			"openai_base_url": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Base URL of the OpenAI compatible API used by the `openai` engine",
+			},
+			"openai_api_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "API key used by the `openai` engine",
+			},
+			"automatic1111_base_url": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Base URL of the AUTOMATIC1111 instance used by the `automatic1111` engine",
+			},
+			"automatic1111_api_auth": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Credentials of the AUTOMATIC1111 API in the form `username:password`",
+			},
+			"comfyui_base_url": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Base URL of the ComfyUI instance used by the `comfyui` engine",
+			},
+			"comfyui_api_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "API key of the ComfyUI instance",
+			},
+			"comfyui_workflow": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "ComfyUI workflow in API format, as JSON",
+			},
+			"gemini_base_url": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Base URL of the Gemini API used by the `gemini` engine",
+			},
+			"gemini_api_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "API key used by the `gemini` engine",
+			},
+			"model": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Default image model, e.g. `dall-e-3` or a checkpoint of the engine",
+			},
+			"size": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Default image size as `WIDTHxHEIGHT`, e.g. `1024x1024`",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^(auto|\d+x\d+)$`), "must be auto or a size such as 1024x1024"),
+				},
+			},
+			"steps": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Default number of sampling steps, used by the `automatic1111` and `comfyui` engines",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *ImageConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Configs
+}
+
+func (r *ImageConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ImageConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *ImageConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ImageConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.GetImageConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read image config, got error: %s", err))
+		return
+	}
+
+	generation, err := r.client.GetImageGenerationConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read image generation config, got error: %s", err))
+		return
+	}
+
+	setImageConfigState(config, generation, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ImageConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ImageConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *ImageConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The settings always exist, so there is nothing to delete
+}
+
+func (r *ImageConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply sends the configured settings and stores the resulting configuration in state.
+// The engine is updated first, since the model is validated against it.
+func (r *ImageConfigResource) apply(ctx context.Context, data *ImageConfigResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	config, err := r.client.UpdateImageConfig(ctx, &configs.ImageConfig{
+		Enabled:          knownBool(data.Enabled),
+		Engine:           knownString(data.Engine),
+		PromptGeneration: knownBool(data.PromptGeneration),
+		OpenAI: &configs.ImageOpenAIConfig{
+			BaseURL: knownString(data.OpenAIBaseURL),
+			APIKey:  knownString(data.OpenAIAPIKey),
+		},
+		Automatic1111: &configs.ImageA1111Config{
+			BaseURL: knownString(data.Automatic1111BaseURL),
+			APIAuth: knownString(data.Automatic1111APIAuth),
+		},
+		ComfyUI: &configs.ImageComfyUIConfig{
+			BaseURL:  knownString(data.ComfyUIBaseURL),
+			APIKey:   knownString(data.ComfyUIAPIKey),
+			Workflow: knownString(data.ComfyUIWorkflow),
+		},
+		Gemini: &configs.ImageGeminiConfig{
+			BaseURL: knownString(data.GeminiBaseURL),
+			APIKey:  knownString(data.GeminiAPIKey),
+		},
+	})
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update image config, got error: %s", err))
+		return
+	}
+
+	generation, err := r.client.UpdateImageGenerationConfig(ctx, &configs.ImageGenerationConfig{
+		Model: knownString(data.Model),
+		Size:  knownString(data.Size),
+		Steps: knownInt64(data.Steps),
+	})
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update image generation config, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("image")
+	setImageConfigState(config, generation, data)
+
+	// Save data into Terraform state
+	diags.Append(state.Set(ctx, data)...)
+}
+
+// setImageConfigState copies the server representation of the settings into
+// the resource data. Credentials are only read when they are configured.
+func setImageConfigState(config *configs.ImageConfig, generation *configs.ImageGenerationConfig, data *ImageConfigResourceModel) {
+	data.Enabled = types.BoolPointerValue(config.Enabled)
+	data.Engine = types.StringPointerValue(config.Engine)
+	data.PromptGeneration = types.BoolPointerValue(config.PromptGeneration)
+	data.OpenAIBaseURL = types.StringPointerValue(config.OpenAI.BaseURL)
+	if !data.OpenAIAPIKey.IsNull() {
+		data.OpenAIAPIKey = types.StringPointerValue(config.OpenAI.APIKey)
+	}
+	data.Automatic1111BaseURL = types.StringPointerValue(config.Automatic1111.BaseURL)
+	if !data.Automatic1111APIAuth.IsNull() {
+		data.Automatic1111APIAuth = types.StringPointerValue(config.Automatic1111.APIAuth)
+	}
+	data.ComfyUIBaseURL = types.StringPointerValue(config.ComfyUI.BaseURL)
+	if !data.ComfyUIAPIKey.IsNull() {
+		data.ComfyUIAPIKey = types.StringPointerValue(config.ComfyUI.APIKey)
+	}
+	data.ComfyUIWorkflow = types.StringPointerValue(config.ComfyUI.Workflow)
+	data.GeminiBaseURL = types.StringPointerValue(config.Gemini.BaseURL)
+	if !data.GeminiAPIKey.IsNull() {
+		data.GeminiAPIKey = types.StringPointerValue(config.Gemini.APIKey)
+	}
+	data.Model = types.StringPointerValue(generation.Model)
+	data.Size = types.StringPointerValue(generation.Size)
+	data.Steps = types.Int64PointerValue(generation.Steps)
+}
@@ -0,0 +1,258 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/configs"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &InterfaceConfigResource{}
+var _ resource.ResourceWithImportState = &InterfaceConfigResource{}
+
+// promptSuggestionAttrTypes are the attribute types of an element of prompt_suggestions.
+var promptSuggestionAttrTypes = map[string]attr.Type{
+	"title":    types.StringType,
+	"subtitle": types.StringType,
+	"content":  types.StringType,
+}
+
+func NewInterfaceConfigResource() resource.Resource {
+	return &InterfaceConfigResource{}
+}
+
+// InterfaceConfigResource defines the resource implementation.
+type InterfaceConfigResource struct {
+	client *configs.Client
+}
+
+// InterfaceConfigResourceModel describes the resource data model.
+type InterfaceConfigResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	DefaultLocale     types.String `tfsdk:"default_locale"`
+	PromptSuggestions types.List   `tfsdk:"prompt_suggestions"`
+}
+
+// promptSuggestionModel describes an element of prompt_suggestions.
+type promptSuggestionModel struct {
+	Title    types.String `tfsdk:"title"`
+	Subtitle types.String `tfsdk:"subtitle"`
+	Content  types.String `tfsdk:"content"`
+}
+
+func (r *InterfaceConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_interface_config"
+}
+
+func (r *InterfaceConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the interface defaults of the instance, such as the prompts suggested when starting a new chat. Requires an admin token. " +
+			"OpenWebUI only has an API for part of these settings, so they are changed by exporting and importing the full configuration of the instance. " +
+			"Settings that are not configured keep their current value. Destroying the resource leaves the settings unchanged",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Always `interface`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_locale": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Language of the interface for users who have not chosen one, e.g. `en-US` or `de-DE`",
+			},
+			"prompt_suggestions": schema.ListNestedAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Prompts suggested when starting a new chat, in display order. Models can override them with their own suggestions",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"title": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Title of the suggestion",
+						},
+						"subtitle": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Text shown below the title",
+						},
+						"content": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Prompt inserted into the message input when the suggestion is selected",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *InterfaceConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Configs
+}
+
+func (r *InterfaceConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data InterfaceConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *InterfaceConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data InterfaceConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.GetInterfaceConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read interface config, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setInterfaceConfigState(ctx, config, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InterfaceConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data InterfaceConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *InterfaceConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The settings always exist, so there is nothing to delete
+}
+
+func (r *InterfaceConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply sends the configured settings and stores the resulting configuration in state.
+func (r *InterfaceConfigResource) apply(ctx context.Context, data *InterfaceConfigResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	suggestions, d := knownPromptSuggestions(ctx, data.PromptSuggestions)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	config, err := r.client.UpdateInterfaceConfig(ctx, &configs.InterfaceConfig{
+		DefaultLocale:     knownString(data.DefaultLocale),
+		PromptSuggestions: suggestions,
+	})
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update interface config, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("interface")
+	diags.Append(setInterfaceConfigState(ctx, config, data)...)
+	if diags.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags.Append(state.Set(ctx, data)...)
+}
+
+// knownPromptSuggestions converts prompt_suggestions into the API
+// representation, or nil when it is not configured.
+func knownPromptSuggestions(ctx context.Context, v types.List) (*[]configs.PromptSuggestion, diag.Diagnostics) {
+	if v.IsNull() || v.IsUnknown() {
+		return nil, nil
+	}
+
+	var elements []promptSuggestionModel
+	diags := v.ElementsAs(ctx, &elements, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	suggestions := make([]configs.PromptSuggestion, 0, len(elements))
+	for _, e := range elements {
+		title := []string{e.Title.ValueString()}
+		if !e.Subtitle.IsNull() {
+			title = append(title, e.Subtitle.ValueString())
+		}
+		suggestions = append(suggestions, configs.PromptSuggestion{Title: title, Content: e.Content.ValueString()})
+	}
+	return &suggestions, diags
+}
+
+// setInterfaceConfigState copies the server representation of the settings into the resource data.
+func setInterfaceConfigState(ctx context.Context, config *configs.InterfaceConfig, data *InterfaceConfigResourceModel) diag.Diagnostics {
+	data.DefaultLocale = types.StringPointerValue(config.DefaultLocale)
+
+	elementType := types.ObjectType{AttrTypes: promptSuggestionAttrTypes}
+	if config.PromptSuggestions == nil {
+		data.PromptSuggestions = types.ListNull(elementType)
+		return nil
+	}
+
+	elements := make([]promptSuggestionModel, 0, len(*config.PromptSuggestions))
+	for _, s := range *config.PromptSuggestions {
+		e := promptSuggestionModel{
+			Title:    types.StringValue(""),
+			Subtitle: types.StringNull(),
+			Content:  types.StringValue(s.Content),
+		}
+		if len(s.Title) > 0 {
+			e.Title = types.StringValue(s.Title[0])
+		}
+		if len(s.Title) > 1 {
+			e.Subtitle = types.StringValue(s.Title[1])
+		}
+		elements = append(elements, e)
+	}
+
+	var diags diag.Diagnostics
+	data.PromptSuggestions, diags = types.ListValueFrom(ctx, elementType, elements)
+	return diags
+}
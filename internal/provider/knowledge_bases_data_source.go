@@ -0,0 +1,154 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/knowledge"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &KnowledgeBasesDataSource{}
+
+func NewKnowledgeBasesDataSource() datasource.DataSource {
+	return &KnowledgeBasesDataSource{}
+}
+
+// KnowledgeBasesDataSource defines the data source implementation.
+type KnowledgeBasesDataSource struct {
+	client *knowledge.Client
+}
+
+// KnowledgeBasesDataSourceModel describes the data source data model.
+type KnowledgeBasesDataSourceModel struct {
+	NamePrefix     types.String              `tfsdk:"name_prefix"`
+	KnowledgeBases []KnowledgeBaseEntryModel `tfsdk:"knowledge_bases"`
+}
+
+// KnowledgeBaseEntryModel describes a single knowledge base in the list.
+type KnowledgeBaseEntryModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	FileCount   types.Int64  `tfsdk:"file_count"`
+	IsPrivate   types.Bool   `tfsdk:"is_private"`
+	CreatedAt   types.Int64  `tfsdk:"created_at"`
+	UpdatedAt   types.Int64  `tfsdk:"updated_at"`
+}
+
+func (d *KnowledgeBasesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_knowledge_bases"
+}
+
+func (d *KnowledgeBasesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the knowledge bases visible to the configured token, e.g. to attach all of them to a model with `for_each`",
+
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return knowledge bases whose name starts with this value",
+			},
+			"knowledge_bases": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching knowledge bases, in the order returned by the server",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Knowledge identifier",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the knowledge base",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Description of the knowledge base",
+						},
+						"file_count": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of files attached to the knowledge base",
+						},
+						"is_private": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the knowledge base is private",
+						},
+						"created_at": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Timestamp when the knowledge base was created",
+						},
+						"updated_at": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Timestamp when the knowledge base was last updated",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *KnowledgeBasesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = clients.Knowledge
+}
+
+func (d *KnowledgeBasesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data KnowledgeBasesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get knowledge bases from API
+	knowledgeBases, err := d.client.List(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read knowledge bases, got error: %s", err))
+		return
+	}
+
+	prefix := data.NamePrefix.ValueString()
+
+	data.KnowledgeBases = make([]KnowledgeBaseEntryModel, 0, len(knowledgeBases))
+	for _, kb := range knowledgeBases {
+		if !strings.HasPrefix(kb.Name, prefix) {
+			continue
+		}
+
+		data.KnowledgeBases = append(data.KnowledgeBases, KnowledgeBaseEntryModel{
+			ID:          types.StringValue(kb.ID),
+			Name:        types.StringValue(kb.Name),
+			Description: types.StringValue(kb.Description),
+			FileCount:   types.Int64Value(int64(len(kb.FileIDs()))),
+			IsPrivate:   types.BoolValue(kb.AccessControl != nil),
+			CreatedAt:   types.Int64Value(kb.CreatedAt),
+			UpdatedAt:   types.Int64Value(kb.UpdatedAt),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
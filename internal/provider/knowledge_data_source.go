@@ -6,12 +6,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	"github.com/coalition-sre/terraform-provider-openwebui/internal/provider/client/knowledge"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/knowledge"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
@@ -32,19 +34,27 @@ type KnowledgeDataSourceModel struct {
 	Name          types.String `tfsdk:"name"`
 	Description   types.String `tfsdk:"description"`
 	Data          types.Map    `tfsdk:"data"`
-	AccessControl types.String `tfsdk:"access_control"`
+	Files         types.List   `tfsdk:"files"`
+	IsPrivate     types.Bool   `tfsdk:"is_private"`
+	AccessControl types.Object `tfsdk:"access_control"`
 	AccessGroups  types.List   `tfsdk:"access_groups"`
 	AccessUsers   types.List   `tfsdk:"access_users"`
 	LastUpdated   types.String `tfsdk:"last_updated"`
 }
 
+// knowledgeFileAttrTypes are the attribute types of the files of a knowledge base.
+var knowledgeFileAttrTypes = map[string]attr.Type{
+	"id":   types.StringType,
+	"name": types.StringType,
+}
+
 func (d *KnowledgeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_knowledge"
 }
 
 func (d *KnowledgeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Knowledge data source for OpenWebUI",
+		MarkdownDescription: "Looks up a knowledge base by its exact name, e.g. to attach an existing collection to a model without hardcoding its ID. Fails if no or more than one knowledge base has the name",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -64,19 +74,38 @@ func (d *KnowledgeDataSource) Schema(ctx context.Context, req datasource.SchemaR
 				Computed:            true,
 				MarkdownDescription: "Additional data for the knowledge base",
 			},
-			"access_control": schema.StringAttribute{
+			"files": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Files attached to the knowledge base",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "File identifier",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the file",
+						},
+					},
+				},
+			},
+			"is_private": schema.BoolAttribute{
 				Computed:            true,
-				MarkdownDescription: "Access control type ('public' or 'private')",
+				MarkdownDescription: "Whether the knowledge base is private",
 			},
+			"access_control": accessControlDataSourceAttribute("knowledge base"),
 			"access_groups": schema.ListAttribute{
 				ElementType:         types.StringType,
 				Computed:            true,
-				MarkdownDescription: "List of group IDs with access",
+				MarkdownDescription: "List of group IDs with read access",
+				DeprecationMessage:  "Use access_control.read.group_ids instead",
 			},
 			"access_users": schema.ListAttribute{
 				ElementType:         types.StringType,
 				Computed:            true,
-				MarkdownDescription: "List of user IDs with access",
+				MarkdownDescription: "List of user IDs with read access",
+				DeprecationMessage:  "Use access_control.read.user_ids instead",
 			},
 			"last_updated": schema.StringAttribute{
 				Computed:            true,
@@ -91,25 +120,16 @@ func (d *KnowledgeDataSource) Configure(ctx context.Context, req datasource.Conf
 		return
 	}
 
-	clients, ok := req.ProviderData.(map[string]interface{})
+	clients, ok := req.ProviderData.(*ProviderClients)
 	if !ok {
 		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	client, ok := clients["knowledge"].(*knowledge.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *knowledge.Client, got: %T. Please report this issue to the provider developers.", clients["knowledge"]),
-		)
-		return
-	}
-
-	d.client = client
+	d.client = clients.Knowledge
 }
 
 func (d *KnowledgeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -122,93 +142,98 @@ func (d *KnowledgeDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 
 	// Get knowledge bases from API
-	knowledgeBases, err := d.client.List()
+	knowledgeBases, err := d.client.List(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read knowledge bases, got error: %s", err))
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read knowledge bases, got error: %s", err))
 		return
 	}
 
 	// Find the knowledge base with matching name
-	var found bool
+	var matches []string
 	for _, kb := range knowledgeBases {
 		if kb.Name == data.Name.ValueString() {
-			// Convert API response to model
-			data.ID = types.StringValue(kb.ID)
-			data.Description = types.StringValue(kb.Description)
-			data.LastUpdated = types.StringValue(fmt.Sprint(kb.UpdatedAt))
-
-			// Convert data map
-			if kb.Data != nil {
-				dataMap := make(map[string]string)
-				for k, v := range kb.Data {
-					if str, ok := v.(string); ok {
-						dataMap[k] = str
-					}
-				}
-				convertedMap, err := types.MapValueFrom(ctx, types.StringType, dataMap)
-				if err != nil {
-					resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert data map, got error: %s", err))
-					return
-				}
-				data.Data = convertedMap
-			}
-
-			// Handle access control
-			if kb.AccessControl == nil {
-				data.AccessControl = types.StringValue("public")
-			} else {
-				data.AccessControl = types.StringValue("private")
-
-				// Extract groups and users from access control
-				if ac, ok := kb.AccessControl.(map[string]interface{}); ok {
-					if read, ok := ac["read"].(map[string]interface{}); ok {
-						// Handle groups
-						if groups, ok := read["group_ids"].([]interface{}); ok {
-							groupList := make([]string, 0, len(groups))
-							for _, g := range groups {
-								if str, ok := g.(string); ok {
-									groupList = append(groupList, str)
-								}
-							}
-							groupsValue, err := types.ListValueFrom(ctx, types.StringType, groupList)
-							if err != nil {
-								resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert groups list, got error: %s", err))
-								return
-							}
-							data.AccessGroups = groupsValue
-						}
-
-						// Handle users
-						if users, ok := read["user_ids"].([]interface{}); ok {
-							userList := make([]string, 0, len(users))
-							for _, u := range users {
-								if str, ok := u.(string); ok {
-									userList = append(userList, str)
-								}
-							}
-							usersValue, err := types.ListValueFrom(ctx, types.StringType, userList)
-							if err != nil {
-								resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Unable to convert users list, got error: %s", err))
-								return
-							}
-							data.AccessUsers = usersValue
-						}
-					}
-				}
-			}
-
-			found = true
-			break
+			matches = append(matches, kb.ID)
 		}
 	}
 
-	if !found {
+	if len(matches) == 0 {
 		resp.Diagnostics.AddError(
 			"Knowledge Base Not Found",
 			fmt.Sprintf("No knowledge base found with name: %s", data.Name.ValueString()),
 		)
 		return
 	}
+	if len(matches) > 1 {
+		resp.Diagnostics.AddError(
+			"Multiple Knowledge Bases Found",
+			fmt.Sprintf("Found %d knowledge bases with name %s (IDs: %s). Rename them so that the name is unique.",
+				len(matches), data.Name.ValueString(), strings.Join(matches, ", ")),
+		)
+		return
+	}
+
+	// The list does not include all attached files, so get the knowledge base itself
+	kb, err := d.client.Get(ctx, matches[0])
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read knowledge base, got error: %s", err))
+		return
+	}
+
+	// Convert API response to model
+	data.ID = types.StringValue(kb.ID)
+	data.Description = types.StringValue(kb.Description)
+	data.LastUpdated = types.StringValue(fmt.Sprint(kb.UpdatedAt))
+
+	// Convert data map
+	dataMap := make(map[string]string)
+	for k, v := range kb.Data {
+		if str, ok := v.(string); ok {
+			dataMap[k] = str
+		}
+	}
+	convertedMap, diags := types.MapValueFrom(ctx, types.StringType, dataMap)
+	resp.Diagnostics.Append(diags...)
+	data.Data = convertedMap
+
+	// Convert attached files
+	files := make([]attr.Value, 0, len(kb.Files))
+	for _, f := range kb.Files {
+		file, diags := types.ObjectValue(knowledgeFileAttrTypes, map[string]attr.Value{
+			"id":   types.StringValue(f.ID),
+			"name": types.StringValue(f.Name()),
+		})
+		resp.Diagnostics.Append(diags...)
+		files = append(files, file)
+	}
+	data.Files, diags = types.ListValue(types.ObjectType{AttrTypes: knowledgeFileAttrTypes}, files)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Handle access control
+	if kb.AccessControl == nil {
+		data.IsPrivate = types.BoolValue(false)
+		data.AccessControl = types.ObjectNull(accessControlAttrTypes)
+		data.AccessGroups = types.ListNull(types.StringType)
+		data.AccessUsers = types.ListNull(types.StringType)
+	} else {
+		ac := kb.AccessControl
+		read := accessGroup{GroupIDs: ac.Read.GroupIDs, UserIDs: ac.Read.UserIDs}
+
+		data.IsPrivate = types.BoolValue(true)
+		data.AccessControl, diags = accessControlObject(ctx, read,
+			accessGroup{GroupIDs: ac.Write.GroupIDs, UserIDs: ac.Write.UserIDs},
+		)
+		resp.Diagnostics.Append(diags...)
+		data.AccessGroups, diags = types.ListValueFrom(ctx, types.StringType, append([]string{}, read.GroupIDs...))
+		resp.Diagnostics.Append(diags...)
+		data.AccessUsers, diags = types.ListValueFrom(ctx, types.StringType, append([]string{}, read.UserIDs...))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
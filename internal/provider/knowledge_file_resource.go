@@ -0,0 +1,348 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/files"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/knowledge"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &KnowledgeFileResource{}
+var _ resource.ResourceWithModifyPlan = &KnowledgeFileResource{}
+
+func NewKnowledgeFileResource() resource.Resource {
+	return &KnowledgeFileResource{}
+}
+
+// KnowledgeFileResource defines the resource implementation.
+type KnowledgeFileResource struct {
+	client      *knowledge.Client
+	filesClient *files.Client
+}
+
+// KnowledgeFileResourceModel describes the resource data model.
+type KnowledgeFileResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	KnowledgeID types.String `tfsdk:"knowledge_id"`
+	Source      types.String `tfsdk:"source"`
+	ContentHash types.String `tfsdk:"content_sha256"`
+	Filename    types.String `tfsdk:"filename"`
+	CreatedAt   types.Int64  `tfsdk:"created_at"`
+	Wait        types.Bool   `tfsdk:"wait_for_processing"`
+	WaitTimeout types.Int64  `tfsdk:"processing_timeout"`
+}
+
+func (r *KnowledgeFileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_knowledge_file"
+}
+
+func (r *KnowledgeFileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Uploads a local file and attaches it to an OpenWebUI knowledge base. The file is detached and deleted on destroy",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the uploaded file",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"knowledge_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Identifier of the knowledge base the file is attached to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Path to the local file to upload. The base name of the path is used as the file name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content_sha256": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "SHA-256 of the uploaded content. When the local file no longer matches it, the file is uploaded again",
+			},
+			"filename": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Name of the uploaded file",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp when the file was uploaded",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"wait_for_processing": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to wait until OpenWebUI has finished processing the upload before attaching it, so that dependent resources see an indexed file",
+			},
+			"processing_timeout": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(300),
+				MarkdownDescription: "Maximum number of seconds to wait for processing when `wait_for_processing` is enabled",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *KnowledgeFileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Knowledge
+	r.filesClient = clients.Files
+}
+
+// ModifyPlan hashes the local file so that only changed content is uploaded again.
+func (r *KnowledgeFileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan KnowledgeFileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Source.IsUnknown() {
+		return
+	}
+
+	hash, err := fileSHA256(plan.Source.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Unable to Read Source File", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), types.StringValue(hash))...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state KnowledgeFileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ContentHash.ValueString() != hash {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("content_sha256"))
+	}
+}
+
+func (r *KnowledgeFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data KnowledgeFileResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	source := data.Source.ValueString()
+	hash, err := fileSHA256(source)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Source File", fmt.Sprintf("Unable to hash %s, got error: %s", source, err))
+		return
+	}
+
+	content, err := os.Open(source)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Source File", fmt.Sprintf("Unable to open %s, got error: %s", source, err))
+		return
+	}
+	defer content.Close()
+
+	// Upload the file
+	file, err := r.filesClient.Upload(ctx, filepath.Base(source), content)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to upload file %s, got error: %s", source, err))
+		return
+	}
+
+	if data.Wait.ValueBool() {
+		timeout := time.Duration(data.WaitTimeout.ValueInt64()) * time.Second
+		if err := waitForFileProcessing(ctx, r.filesClient, file.ID, timeout); err != nil {
+			resp.Diagnostics.AddError("File Processing Failed", fmt.Sprintf("File %s (%s) was not processed: %s", source, file.ID, err))
+			if err := r.filesClient.Delete(ctx, file.ID); err != nil {
+				resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete uploaded file %s, got error: %s", file.ID, err))
+			}
+			return
+		}
+	}
+
+	// Attach it to the knowledge base, removing the upload again if that fails
+	if _, err := r.client.AddFile(ctx, data.KnowledgeID.ValueString(), file.ID); err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to add file %s to knowledge base, got error: %s", source, err))
+		if err := r.filesClient.Delete(ctx, file.ID); err != nil {
+			resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete uploaded file %s, got error: %s", file.ID, err))
+		}
+		return
+	}
+
+	// Map response to model
+	data.ID = types.StringValue(file.ID)
+	data.ContentHash = types.StringValue(hash)
+	data.Filename = types.StringValue(file.Filename)
+	data.CreatedAt = types.Int64Value(file.CreatedAt)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KnowledgeFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data KnowledgeFileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A file detached outside of Terraform has to be attached again
+	kb, err := r.client.Get(ctx, data.KnowledgeID.ValueString())
+	if err != nil {
+		if errors.Is(err, apierror.ErrNotFound) {
+			// The file goes away with the knowledge base
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read knowledge base, got error: %s", err))
+		return
+	}
+
+	attached := false
+	for _, id := range kb.FileIDs() {
+		if id == data.ID.ValueString() {
+			attached = true
+			break
+		}
+	}
+	if !attached {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	file, err := r.filesClient.Get(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read file, got error: %s", err))
+		return
+	}
+
+	// Map response to model
+	data.Filename = types.StringValue(file.Filename)
+	data.CreatedAt = types.Int64Value(file.CreatedAt)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KnowledgeFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data KnowledgeFileResourceModel
+
+	// Every attribute sent to the server requires replacement, so only the plan is stored
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KnowledgeFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data KnowledgeFileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Detach the file from the knowledge base, then delete the upload itself
+	if _, err := r.client.RemoveFile(ctx, data.KnowledgeID.ValueString(), data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to remove file from knowledge base, got error: %s", err))
+		return
+	}
+
+	if err := r.filesClient.Delete(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete file, got error: %s", err))
+		return
+	}
+}
+
+// knowledgeFilePollInterval is how often the processing status of a file is checked
+const knowledgeFilePollInterval = 2 * time.Second
+
+// waitForFileProcessing polls a file until the server reports it as processed.
+// Servers that do not report a processing status are treated as done.
+func waitForFileProcessing(ctx context.Context, client *files.Client, id string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		file, err := client.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		switch file.ProcessingStatus() {
+		case "", "completed":
+			return nil
+		case "failed":
+			if msg := file.ProcessingError(); msg != "" {
+				return fmt.Errorf("processing failed: %s", msg)
+			}
+			return fmt.Errorf("processing failed")
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("still %q after %s", file.ProcessingStatus(), timeout)
+		case <-time.After(knowledgeFilePollInterval):
+		}
+	}
+}
@@ -5,21 +5,26 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	"github.com/coalition-sre/terraform-provider-openwebui/internal/provider/client/knowledge"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/knowledge"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &KnowledgeResource{}
 var _ resource.ResourceWithImportState = &KnowledgeResource{}
+var _ resource.ResourceWithUpgradeState = &KnowledgeResource{}
 
 func NewKnowledgeResource() resource.Resource {
 	return &KnowledgeResource{}
@@ -32,12 +37,14 @@ type KnowledgeResource struct {
 
 // KnowledgeResourceModel describes the resource data model.
 type KnowledgeResourceModel struct {
-	ID            types.String `tfsdk:"id"`
-	Name          types.String `tfsdk:"name"`
-	Description   types.String `tfsdk:"description"`
-	Data          types.Map    `tfsdk:"data"`
-	AccessControl types.String `tfsdk:"access_control"`
-	LastUpdated   types.String `tfsdk:"last_updated"`
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Data            types.Map    `tfsdk:"data"`
+	IsPrivate       types.Bool   `tfsdk:"is_private"`
+	AccessControl   types.Object `tfsdk:"access_control"`
+	LastUpdated     types.String `tfsdk:"last_updated"`
+	LockOnUpdatedAt types.Bool   `tfsdk:"lock_on_updated_at"`
 }
 
 func (r *KnowledgeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -47,6 +54,7 @@ func (r *KnowledgeResource) Metadata(ctx context.Context, req resource.MetadataR
 func (r *KnowledgeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Knowledge resource for OpenWebUI",
+		Version:             1,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -69,15 +77,23 @@ func (r *KnowledgeResource) Schema(ctx context.Context, req resource.SchemaReque
 				Optional:            true,
 				MarkdownDescription: "Additional data for the knowledge base",
 			},
-			"access_control": schema.StringAttribute{
-				MarkdownDescription: "Access control type ('public' or 'private')",
+			"is_private": schema.BoolAttribute{
 				Optional:            true,
 				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the knowledge base is private. `access_control` must be unset when this is set to `false`",
 			},
+			"access_control": accessControlResourceAttribute("knowledge base"),
 			"last_updated": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Timestamp of the last update",
 			},
+			"lock_on_updated_at": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether updates are aborted when the knowledge base was changed outside of Terraform, i.e. when the server's update timestamp no longer matches `last_updated`",
+			},
 		},
 	}
 }
@@ -87,25 +103,16 @@ func (r *KnowledgeResource) Configure(ctx context.Context, req resource.Configur
 		return
 	}
 
-	clients, ok := req.ProviderData.(map[string]interface{})
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
-	}
-
-	client, ok := clients["knowledge"].(*knowledge.Client)
+	clients, ok := req.ProviderData.(*ProviderClients)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *knowledge.Client, got: %T. Please report this issue to the provider developers.", clients["knowledge"]),
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = clients.Knowledge
 }
 
 func (r *KnowledgeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -118,41 +125,26 @@ func (r *KnowledgeResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	// Convert data model to API form
-	form := &knowledge.KnowledgeForm{
-		Name:        data.Name.ValueString(),
-		Description: data.Description.ValueString(),
-	}
-
-	// Handle data map
-	if !data.Data.IsNull() {
-		dataMap := make(map[string]string)
-		resp.Diagnostics.Append(data.Data.ElementsAs(ctx, &dataMap, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		form.Data = dataMap
-	}
-
-	// Handle access control
-	if !data.AccessControl.IsNull() {
-		accessType := data.AccessControl.ValueString()
-		if accessType == "private" {
-			form.AccessControl = map[string]interface{}{
-				"type": "private",
-			}
-		}
+	form, diags := knowledgeForm(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Create new knowledge base
-	result, err := r.client.Create(form)
+	result, err := r.client.Create(ctx, form)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create knowledge base, got error: %s", err))
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to create knowledge base, got error: %s", err))
 		return
 	}
 
 	// Map response to model
 	data.ID = types.StringValue(result.ID)
 	data.LastUpdated = types.StringValue(fmt.Sprint(result.UpdatedAt))
+	resp.Diagnostics.Append(setKnowledgeAccessControl(ctx, result.AccessControl, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -168,9 +160,14 @@ func (r *KnowledgeResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Get knowledge base from API
-	result, err := r.client.Get(data.ID.ValueString())
+	result, err := r.client.Get(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read knowledge base, got error: %s", err))
+		if errors.Is(err, apierror.ErrNotFound) {
+			// Deleted outside of Terraform, plan to create it again
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read knowledge base, got error: %s", err))
 		return
 	}
 
@@ -196,10 +193,9 @@ func (r *KnowledgeResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Handle access control
-	if result.AccessControl == nil {
-		data.AccessControl = types.StringValue("public")
-	} else {
-		data.AccessControl = types.StringValue("private")
+	resp.Diagnostics.Append(setKnowledgeAccessControl(ctx, result.AccessControl, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Save updated data into Terraform state
@@ -216,40 +212,51 @@ func (r *KnowledgeResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	// Convert data model to API form
-	form := &knowledge.KnowledgeForm{
-		Name:        data.Name.ValueString(),
-		Description: data.Description.ValueString(),
+	form, diags := knowledgeForm(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Handle data map
-	if !data.Data.IsNull() {
-		dataMap := make(map[string]string)
-		resp.Diagnostics.Append(data.Data.ElementsAs(ctx, &dataMap, false)...)
+	if data.LockOnUpdatedAt.ValueBool() {
+		var state KnowledgeResourceModel
+
+		// Read Terraform prior state data to compare the recorded timestamp
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		form.Data = dataMap
-	}
 
-	// Handle access control
-	if !data.AccessControl.IsNull() {
-		accessType := data.AccessControl.ValueString()
-		if accessType == "private" {
-			form.AccessControl = map[string]interface{}{
-				"type": "private",
-			}
+		current, err := r.client.Get(ctx, data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read knowledge base, got error: %s", err))
+			return
+		}
+
+		if fmt.Sprint(current.UpdatedAt) != state.LastUpdated.ValueString() {
+			resp.Diagnostics.AddError(
+				"Knowledge Base Changed Outside of Terraform",
+				fmt.Sprintf("Knowledge base %s was updated on the server at %d, but Terraform state records %s. "+
+					"Refresh first (e.g. terraform apply -refresh-only) and review the changes before applying again.",
+					data.ID.ValueString(), current.UpdatedAt, state.LastUpdated.ValueString()),
+			)
+			return
 		}
 	}
 
 	// Update knowledge base
-	result, err := r.client.Update(data.ID.ValueString(), form)
+	result, err := r.client.Update(ctx, data.ID.ValueString(), form)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update knowledge base, got error: %s", err))
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update knowledge base, got error: %s", err))
 		return
 	}
 
 	// Update last updated timestamp
 	data.LastUpdated = types.StringValue(fmt.Sprint(result.UpdatedAt))
+	resp.Diagnostics.Append(setKnowledgeAccessControl(ctx, result.AccessControl, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -265,9 +272,9 @@ func (r *KnowledgeResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 
 	// Delete knowledge base
-	err := r.client.Delete(data.ID.ValueString())
+	err := r.client.Delete(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete knowledge base, got error: %s", err))
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete knowledge base, got error: %s", err))
 		return
 	}
 }
@@ -275,3 +282,115 @@ func (r *KnowledgeResource) Delete(ctx context.Context, req resource.DeleteReque
 func (r *KnowledgeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func (r *KnowledgeResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 stored access_control as the string "public" or "private"
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":                 schema.StringAttribute{Computed: true},
+					"name":               schema.StringAttribute{Required: true},
+					"description":        schema.StringAttribute{Required: true},
+					"data":               schema.MapAttribute{ElementType: types.StringType, Optional: true},
+					"access_control":     schema.StringAttribute{Optional: true, Computed: true},
+					"last_updated":       schema.StringAttribute{Computed: true},
+					"lock_on_updated_at": schema.BoolAttribute{Optional: true, Computed: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior struct {
+					ID              types.String `tfsdk:"id"`
+					Name            types.String `tfsdk:"name"`
+					Description     types.String `tfsdk:"description"`
+					Data            types.Map    `tfsdk:"data"`
+					AccessControl   types.String `tfsdk:"access_control"`
+					LastUpdated     types.String `tfsdk:"last_updated"`
+					LockOnUpdatedAt types.Bool   `tfsdk:"lock_on_updated_at"`
+				}
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				data := KnowledgeResourceModel{
+					ID:              prior.ID,
+					Name:            prior.Name,
+					Description:     prior.Description,
+					Data:            prior.Data,
+					LastUpdated:     prior.LastUpdated,
+					LockOnUpdatedAt: prior.LockOnUpdatedAt,
+				}
+
+				// The old format did not record any grants, so private knowledge bases
+				// start with none and the next refresh fills in the actual ones
+				var accessControl *knowledge.AccessControl
+				if prior.AccessControl.ValueString() == "private" {
+					accessControl = &knowledge.AccessControl{}
+				}
+				resp.Diagnostics.Append(setKnowledgeAccessControl(ctx, accessControl, &data)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			},
+		},
+	}
+}
+
+// knowledgeForm converts the planned resource data into the API payload.
+func knowledgeForm(ctx context.Context, data *KnowledgeResourceModel) (*knowledge.KnowledgeForm, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	form := &knowledge.KnowledgeForm{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+	}
+
+	// Handle data map
+	if !data.Data.IsNull() {
+		dataMap := make(map[string]string)
+		diags.Append(data.Data.ElementsAs(ctx, &dataMap, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		form.Data = dataMap
+	}
+
+	// Public knowledge bases have no access control at all
+	if !data.IsPrivate.ValueBool() || data.AccessControl.IsNull() || data.AccessControl.IsUnknown() {
+		return form, diags
+	}
+
+	read, write, d := accessControlFromObject(ctx, data.AccessControl)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	form.AccessControl = &knowledge.AccessControl{
+		Read:  knowledge.AccessGroup{GroupIDs: read.GroupIDs, UserIDs: read.UserIDs},
+		Write: knowledge.AccessGroup{GroupIDs: write.GroupIDs, UserIDs: write.UserIDs},
+	}
+
+	return form, diags
+}
+
+// setKnowledgeAccessControl copies the server's access control into is_private and access_control.
+func setKnowledgeAccessControl(ctx context.Context, ac *knowledge.AccessControl, data *KnowledgeResourceModel) diag.Diagnostics {
+	if ac == nil {
+		data.IsPrivate = types.BoolValue(false)
+		data.AccessControl = types.ObjectNull(accessControlAttrTypes)
+		return nil
+	}
+
+	accessControl, diags := accessControlObject(ctx,
+		accessGroup{GroupIDs: ac.Read.GroupIDs, UserIDs: ac.Read.UserIDs},
+		accessGroup{GroupIDs: ac.Write.GroupIDs, UserIDs: ac.Write.UserIDs},
+	)
+	data.IsPrivate = types.BoolValue(true)
+	data.AccessControl = accessControl
+
+	return diags
+}
@@ -0,0 +1,490 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/files"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/knowledge"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &KnowledgeSyncResource{}
+var _ resource.ResourceWithModifyPlan = &KnowledgeSyncResource{}
+
+func NewKnowledgeSyncResource() resource.Resource {
+	return &KnowledgeSyncResource{}
+}
+
+// KnowledgeSyncResource defines the resource implementation.
+type KnowledgeSyncResource struct {
+	client      *knowledge.Client
+	filesClient *files.Client
+}
+
+// KnowledgeSyncResourceModel describes the resource data model.
+type KnowledgeSyncResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	KnowledgeID types.String `tfsdk:"knowledge_id"`
+	Directory   types.String `tfsdk:"directory"`
+	Pattern     types.String `tfsdk:"pattern"`
+	Files       types.Map    `tfsdk:"files"`
+	Wait        types.Bool   `tfsdk:"wait_for_processing"`
+	WaitTimeout types.Int64  `tfsdk:"processing_timeout"`
+}
+
+// KnowledgeSyncFileModel describes a single synced file.
+type KnowledgeSyncFileModel struct {
+	ID     types.String `tfsdk:"id"`
+	SHA256 types.String `tfsdk:"sha256"`
+}
+
+var knowledgeSyncFileAttrTypes = map[string]attr.Type{
+	"id":     types.StringType,
+	"sha256": types.StringType,
+}
+
+func (r *KnowledgeSyncResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_knowledge_sync"
+}
+
+func (r *KnowledgeSyncResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Keeps the files of an OpenWebUI knowledge base in sync with a local directory. " +
+			"Every matching file is uploaded and attached, changed files are uploaded again and files removed from the directory are detached and deleted. " +
+			"Files attached to the knowledge base by other means are left alone",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the sync, equal to `knowledge_id`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"knowledge_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Identifier of the knowledge base to sync into",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"directory": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Local directory to sync. Subdirectories are searched recursively",
+			},
+			"pattern": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("*"),
+				MarkdownDescription: "Glob matched against the name of each file, e.g. `*.md`. Defaults to all files",
+			},
+			"files": schema.MapNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Synced files keyed by their path relative to `directory`",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Identifier of the uploaded file",
+						},
+						"sha256": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "SHA-256 of the file content at upload time",
+						},
+					},
+				},
+			},
+			"wait_for_processing": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to wait until OpenWebUI has finished processing each upload before attaching it",
+			},
+			"processing_timeout": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(300),
+				MarkdownDescription: "Maximum number of seconds to wait for each file when `wait_for_processing` is enabled",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *KnowledgeSyncResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Knowledge
+	r.filesClient = clients.Files
+}
+
+// ModifyPlan hashes the local files so that the plan only shows a change to
+// `files` when something in the directory was added, changed or removed.
+func (r *KnowledgeSyncResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state KnowledgeSyncResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Directory.IsUnknown() || plan.Pattern.IsUnknown() {
+		return
+	}
+
+	local, err := scanKnowledgeDirectory(plan.Directory.ValueString(), plan.Pattern.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("directory"), "Unable to Read Directory", err.Error())
+		return
+	}
+
+	synced, diags := knowledgeSyncFiles(ctx, state.Files)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unchanged := len(local) == len(synced)
+	for name, hash := range local {
+		if file, ok := synced[name]; !ok || file.SHA256.ValueString() != hash {
+			unchanged = false
+			break
+		}
+	}
+
+	if unchanged {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("files"), state.Files)...)
+	} else {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("files"), types.MapUnknown(types.ObjectType{AttrTypes: knowledgeSyncFileAttrTypes}))...)
+	}
+}
+
+func (r *KnowledgeSyncResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data KnowledgeSyncResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	synced, diags := r.sync(ctx, &data, map[string]KnowledgeSyncFileModel{})
+	resp.Diagnostics.Append(diags...)
+
+	data.ID = data.KnowledgeID
+	data.Files, diags = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: knowledgeSyncFileAttrTypes}, synced)
+	resp.Diagnostics.Append(diags...)
+
+	// Files uploaded before a failure are saved as well, so the next apply picks up from there
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KnowledgeSyncResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data KnowledgeSyncResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kb, err := r.client.Get(ctx, data.KnowledgeID.ValueString())
+	if err != nil {
+		if errors.Is(err, apierror.ErrNotFound) {
+			// The files go away with the knowledge base
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read knowledge base, got error: %s", err))
+		return
+	}
+
+	synced, diags := knowledgeSyncFiles(ctx, data.Files)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Forget files detached outside of Terraform so the next apply uploads them again
+	attached := make(map[string]bool)
+	for _, id := range kb.FileIDs() {
+		attached[id] = true
+	}
+	for name, file := range synced {
+		if !attached[file.ID.ValueString()] {
+			delete(synced, name)
+		}
+	}
+
+	data.Files, diags = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: knowledgeSyncFileAttrTypes}, synced)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KnowledgeSyncResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state KnowledgeSyncResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prior, diags := knowledgeSyncFiles(ctx, state.Files)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	synced, diags := r.sync(ctx, &data, prior)
+	resp.Diagnostics.Append(diags...)
+
+	data.ID = state.ID
+	data.Files, diags = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: knowledgeSyncFileAttrTypes}, synced)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KnowledgeSyncResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data KnowledgeSyncResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	synced, diags := knowledgeSyncFiles(ctx, data.Files)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, file := range synced {
+		if err := r.removeFile(ctx, data.KnowledgeID.ValueString(), file.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to remove %s from knowledge base, got error: %s", name, err))
+		}
+	}
+}
+
+// sync reconciles the knowledge base with the local directory. It returns the
+// files that are synced afterwards, which includes the files that were left
+// untouched because of an error.
+func (r *KnowledgeSyncResource) sync(ctx context.Context, data *KnowledgeSyncResourceModel, prior map[string]KnowledgeSyncFileModel) (map[string]KnowledgeSyncFileModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	directory := data.Directory.ValueString()
+	knowledgeID := data.KnowledgeID.ValueString()
+
+	local, err := scanKnowledgeDirectory(directory, data.Pattern.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("directory"), "Unable to Read Directory", err.Error())
+		return prior, diags
+	}
+
+	synced := make(map[string]KnowledgeSyncFileModel, len(local))
+
+	// Detach files that were removed from the directory
+	for name, file := range prior {
+		if _, ok := local[name]; ok {
+			continue
+		}
+		if err := r.removeFile(ctx, knowledgeID, file.ID.ValueString()); err != nil {
+			diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to remove %s from knowledge base, got error: %s", name, err))
+			synced[name] = file
+		}
+	}
+
+	names := make([]string, 0, len(local))
+	for name := range local {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// Upload new and changed files
+	for _, name := range names {
+		hash := local[name]
+		if file, ok := prior[name]; ok {
+			if file.SHA256.ValueString() == hash {
+				synced[name] = file
+				continue
+			}
+			if err := r.removeFile(ctx, knowledgeID, file.ID.ValueString()); err != nil {
+				diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to remove previous version of %s from knowledge base, got error: %s", name, err))
+				synced[name] = file
+				continue
+			}
+		}
+
+		id, err := r.addFile(ctx, data, filepath.Join(directory, filepath.FromSlash(name)))
+		if err != nil {
+			diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to add %s to knowledge base, got error: %s", name, err))
+			continue
+		}
+
+		synced[name] = KnowledgeSyncFileModel{
+			ID:     types.StringValue(id),
+			SHA256: types.StringValue(hash),
+		}
+	}
+
+	return synced, diags
+}
+
+// addFile uploads a local file and attaches it to the knowledge base
+func (r *KnowledgeSyncResource) addFile(ctx context.Context, data *KnowledgeSyncResourceModel, source string) (string, error) {
+	content, err := os.Open(source)
+	if err != nil {
+		return "", err
+	}
+	defer content.Close()
+
+	file, err := r.filesClient.Upload(ctx, filepath.Base(source), content)
+	if err != nil {
+		return "", err
+	}
+
+	if data.Wait.ValueBool() {
+		timeout := time.Duration(data.WaitTimeout.ValueInt64()) * time.Second
+		if err := waitForFileProcessing(ctx, r.filesClient, file.ID, timeout); err != nil {
+			err = fmt.Errorf("file %s was not processed: %v", file.ID, err)
+			if deleteErr := r.filesClient.Delete(ctx, file.ID); deleteErr != nil {
+				return "", fmt.Errorf("%v (deleting the uploaded file failed as well: %v)", err, deleteErr)
+			}
+			return "", err
+		}
+	}
+
+	if _, err := r.client.AddFile(ctx, data.KnowledgeID.ValueString(), file.ID); err != nil {
+		if deleteErr := r.filesClient.Delete(ctx, file.ID); deleteErr != nil {
+			return "", fmt.Errorf("%v (deleting the uploaded file failed as well: %v)", err, deleteErr)
+		}
+		return "", err
+	}
+
+	return file.ID, nil
+}
+
+// removeFile detaches a file from the knowledge base and deletes it
+func (r *KnowledgeSyncResource) removeFile(ctx context.Context, knowledgeID, fileID string) error {
+	if _, err := r.client.RemoveFile(ctx, knowledgeID, fileID); err != nil {
+		return err
+	}
+	return r.filesClient.Delete(ctx, fileID)
+}
+
+// knowledgeSyncFiles converts the `files` attribute into a map that can be modified
+func knowledgeSyncFiles(ctx context.Context, value types.Map) (map[string]KnowledgeSyncFileModel, diag.Diagnostics) {
+	synced := make(map[string]KnowledgeSyncFileModel)
+	if value.IsNull() || value.IsUnknown() {
+		return synced, nil
+	}
+	diags := value.ElementsAs(ctx, &synced, false)
+	return synced, diags
+}
+
+// scanKnowledgeDirectory walks the directory and returns the SHA-256 of every
+// file whose name matches the pattern, keyed by its slash separated relative path.
+func scanKnowledgeDirectory(directory, pattern string) (map[string]string, error) {
+	if _, err := filepath.Match(pattern, ""); err != nil {
+		return nil, fmt.Errorf("invalid pattern %q: %v", pattern, err)
+	}
+
+	hashes := make(map[string]string)
+	err := filepath.WalkDir(directory, func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		if ok, _ := filepath.Match(pattern, entry.Name()); !ok {
+			return nil
+		}
+
+		hash, err := fileSHA256(name)
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(directory, name)
+		if err != nil {
+			return err
+		}
+		hashes[filepath.ToSlash(rel)] = hash
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hashes, nil
+}
+
+// fileSHA256 returns the hex encoded SHA-256 of a file's content
+func fileSHA256(name string) (string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,327 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/configs"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &LDAPConfigResource{}
+var _ resource.ResourceWithImportState = &LDAPConfigResource{}
+
+func NewLDAPConfigResource() resource.Resource {
+	return &LDAPConfigResource{}
+}
+
+// LDAPConfigResource defines the resource implementation.
+type LDAPConfigResource struct {
+	client *configs.Client
+}
+
+// LDAPConfigResourceModel describes the resource data model.
+type LDAPConfigResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	Enabled               types.Bool   `tfsdk:"enabled"`
+	Label                 types.String `tfsdk:"label"`
+	Host                  types.String `tfsdk:"host"`
+	Port                  types.Int64  `tfsdk:"port"`
+	BindDN                types.String `tfsdk:"bind_dn"`
+	BindPassword          types.String `tfsdk:"bind_password"`
+	BindPasswordWO        types.String `tfsdk:"bind_password_wo"`
+	BindPasswordWOVersion types.Int64  `tfsdk:"bind_password_wo_version"`
+	SearchBase            types.String `tfsdk:"search_base"`
+	SearchFilters         types.String `tfsdk:"search_filters"`
+	AttributeForUsername  types.String `tfsdk:"attribute_for_username"`
+	AttributeForMail      types.String `tfsdk:"attribute_for_mail"`
+	UseTLS                types.Bool   `tfsdk:"use_tls"`
+	CertificatePath       types.String `tfsdk:"certificate_path"`
+	ValidateCert          types.Bool   `tfsdk:"validate_cert"`
+	Ciphers               types.String `tfsdk:"ciphers"`
+}
+
+func (r *LDAPConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ldap_config"
+}
+
+func (r *LDAPConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages LDAP authentication, e.g. against Active Directory. Requires an admin token. " +
+			"Settings that are not configured keep their current value. Destroying the resource leaves the settings unchanged",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Always `ldap`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether users can sign in with their LDAP credentials",
+			},
+			"label": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Name of the directory shown on the sign in page",
+			},
+			"host": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Host name of the LDAP server",
+			},
+			"port": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Port of the LDAP server, e.g. `389` or `636`",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"bind_dn": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Distinguished name of the account used to search the directory",
+			},
+			"bind_password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Password of the `bind_dn` account. It is stored in the Terraform state, use `bind_password_wo` to avoid that",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("bind_password_wo")),
+				},
+			},
+			"bind_password_wo": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "Password of the `bind_dn` account as a write-only argument, which is never stored in the plan or state. Requires Terraform 1.11 or later. Increment `bind_password_wo_version` to send a new password",
+			},
+			"bind_password_wo_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Any change to this value sends `bind_password_wo` to OpenWebUI again",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("bind_password_wo")),
+				},
+			},
+			"search_base": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Base DN under which users are searched, e.g. `ou=users,dc=example,dc=com`",
+			},
+			"search_filters": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Additional LDAP filter users must match, e.g. `(memberOf=cn=openwebui,ou=groups,dc=example,dc=com)`",
+			},
+			"attribute_for_username": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Attribute matched against the user name entered on the sign in page, e.g. `uid` or `sAMAccountName`",
+			},
+			"attribute_for_mail": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Attribute holding the email address of a user, e.g. `mail`",
+			},
+			"use_tls": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether the connection to the LDAP server uses TLS",
+			},
+			"certificate_path": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Path of the CA certificate file on the OpenWebUI server used to verify the LDAP server",
+			},
+			"validate_cert": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether the certificate of the LDAP server is verified",
+			},
+			"ciphers": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "OpenSSL cipher list used for TLS connections, e.g. `ALL`",
+			},
+		},
+	}
+}
+
+func (r *LDAPConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Configs
+}
+
+func (r *LDAPConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data LDAPConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, req.Config, true, &resp.State, &resp.Diagnostics)
+}
+
+func (r *LDAPConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data LDAPConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LDAPConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state LDAPConfigResourceModel
+
+	// Read Terraform plan and prior state data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only passwords are not in the state, so their version is compared instead
+	sendPasswordWO := !data.BindPasswordWOVersion.Equal(state.BindPasswordWOVersion)
+
+	r.apply(ctx, &data, req.Config, sendPasswordWO, &resp.State, &resp.Diagnostics)
+}
+
+func (r *LDAPConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The settings always exist, so there is nothing to delete
+}
+
+func (r *LDAPConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply sends the configured settings and stores the resulting configuration
+// in state. The write-only password is taken from config when sendPasswordWO is set.
+func (r *LDAPConfigResource) apply(ctx context.Context, data *LDAPConfigResourceModel, config tfsdk.Config, sendPasswordWO bool, state *tfsdk.State, diags *diag.Diagnostics) {
+	password := knownString(data.BindPassword)
+	if password == nil && sendPasswordWO {
+		var passwordWO types.String
+		diags.Append(config.GetAttribute(ctx, path.Root("bind_password_wo"), &passwordWO)...)
+		if diags.HasError() {
+			return
+		}
+		password = knownString(passwordWO)
+	}
+
+	// The server settings are updated first, so that LDAP is never enabled
+	// with an incomplete configuration
+	_, err := r.client.UpdateLDAPServer(ctx, &configs.LDAPServerConfig{
+		Label:                knownString(data.Label),
+		Host:                 knownString(data.Host),
+		Port:                 knownInt64(data.Port),
+		AttributeForMail:     knownString(data.AttributeForMail),
+		AttributeForUsername: knownString(data.AttributeForUsername),
+		AppDN:                knownString(data.BindDN),
+		AppDNPassword:        password,
+		SearchBase:           knownString(data.SearchBase),
+		SearchFilters:        knownString(data.SearchFilters),
+		UseTLS:               knownBool(data.UseTLS),
+		CertificatePath:      knownString(data.CertificatePath),
+		ValidateCert:         knownBool(data.ValidateCert),
+		Ciphers:              knownString(data.Ciphers),
+	})
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update LDAP server config, got error: %s", err))
+		return
+	}
+
+	if enabled := knownBool(data.Enabled); enabled != nil {
+		if err := r.client.SetLDAPEnabled(ctx, *enabled); err != nil {
+			diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update LDAP config, got error: %s", err))
+			return
+		}
+	}
+
+	data.ID = types.StringValue("ldap")
+	diags.Append(r.read(ctx, data)...)
+	if diags.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags.Append(state.Set(ctx, data)...)
+}
+
+// read copies the current settings into the resource data. The password is
+// only read when it is managed through bind_password, to detect changes to it.
+func (r *LDAPConfigResource) read(ctx context.Context, data *LDAPConfigResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	enabled, err := r.client.GetLDAPEnabled(ctx)
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read LDAP config, got error: %s", err))
+		return diags
+	}
+
+	server, err := r.client.GetLDAPServer(ctx)
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read LDAP server config, got error: %s", err))
+		return diags
+	}
+
+	data.Enabled = types.BoolValue(enabled)
+	data.Label = types.StringPointerValue(server.Label)
+	data.Host = types.StringPointerValue(server.Host)
+	data.Port = types.Int64PointerValue(server.Port)
+	data.BindDN = types.StringPointerValue(server.AppDN)
+	if !data.BindPassword.IsNull() {
+		data.BindPassword = types.StringPointerValue(server.AppDNPassword)
+	}
+	data.SearchBase = types.StringPointerValue(server.SearchBase)
+	data.SearchFilters = types.StringPointerValue(server.SearchFilters)
+	data.AttributeForUsername = types.StringPointerValue(server.AttributeForUsername)
+	data.AttributeForMail = types.StringPointerValue(server.AttributeForMail)
+	data.UseTLS = types.BoolPointerValue(server.UseTLS)
+	data.CertificatePath = types.StringPointerValue(server.CertificatePath)
+	data.ValidateCert = types.BoolPointerValue(server.ValidateCert)
+	data.Ciphers = types.StringPointerValue(server.Ciphers)
+
+	return diags
+}
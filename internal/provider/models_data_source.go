@@ -6,14 +6,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	"github.com/coalition-sre/terraform-provider-openwebui/internal/provider/client/models"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/models"
 )
 
 var (
@@ -34,11 +33,12 @@ func (d *ModelDataSource) Metadata(_ context.Context, req datasource.MetadataReq
 
 func (d *ModelDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches a model by name.",
+		Description: "Fetches a model by ID or name.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "The ID of the model.",
-				Required:    true,
+				Description: "The ID of the model. Exactly one of `id` or `name` must be set.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"user_id": schema.StringAttribute{
 				Description: "The ID of the user who created the model.",
@@ -49,7 +49,8 @@ func (d *ModelDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Computed:    true,
 			},
 			"name": schema.StringAttribute{
-				Description: "The name of the model.",
+				Description: "The name of the model. Exactly one of `id` or `name` must be set. The lookup fails when several models share the name.",
+				Optional:    true,
 				Computed:    true,
 			},
 			"params": schema.SingleNestedAttribute{
@@ -92,7 +93,7 @@ func (d *ModelDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 						Description: "Random seed for reproducibility.",
 						Computed:    true,
 					},
-					"frequency_penalty": schema.Int64Attribute{
+					"frequency_penalty": schema.Float64Attribute{
 						Description: "Frequency penalty.",
 						Computed:    true,
 					},
@@ -113,11 +114,58 @@ func (d *ModelDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 						Computed:    true,
 					},
 					"function_calling": schema.StringAttribute{
-						Description: "Type of function calling support (set to 'native' if enabled).",
+						Description: "Function calling mode, 'default' or 'native'. Null when the server default is used.",
+						Computed:    true,
+					},
+					"stop": schema.ListAttribute{
+						Description: "Sequences that stop the generation when encountered.",
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					"logit_bias": schema.MapAttribute{
+						Description: "Bias added to the likelihood of tokens, keyed by token ID.",
+						Computed:    true,
+						ElementType: types.Int64Type,
+					},
+					"mirostat": schema.Int64Attribute{
+						Description: "Mirostat sampling mode for Ollama models: 0 disables it, 1 selects Mirostat and 2 Mirostat 2.0.",
+						Computed:    true,
+					},
+					"mirostat_eta": schema.Float64Attribute{
+						Description: "Mirostat learning rate.",
+						Computed:    true,
+					},
+					"mirostat_tau": schema.Float64Attribute{
+						Description: "Mirostat target entropy.",
+						Computed:    true,
+					},
+					"num_gpu": schema.Int64Attribute{
+						Description: "Number of layers to offload to the GPU.",
+						Computed:    true,
+					},
+					"num_thread": schema.Int64Attribute{
+						Description: "Number of CPU threads used for generation.",
+						Computed:    true,
+					},
+					"repeat_penalty": schema.Float64Attribute{
+						Description: "Penalty applied to repeated tokens.",
+						Computed:    true,
+					},
+					"tfs_z": schema.Float64Attribute{
+						Description: "Tail free sampling value.",
+						Computed:    true,
+					},
+					"use_mmap": schema.BoolAttribute{
+						Description: "Whether to memory-map the model.",
+						Computed:    true,
+					},
+					"use_mlock": schema.BoolAttribute{
+						Description: "Whether to lock the model in memory.",
+						Computed:    true,
+					},
+					"presence_penalty": schema.Float64Attribute{
+						Description: "Presence penalty.",
 						Computed:    true,
-						Validators: []validator.String{
-							stringvalidator.OneOf("native"),
-						},
 					},
 				},
 			},
@@ -149,6 +197,22 @@ func (d *ModelDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 								Description: "Whether the model supports citations.",
 								Computed:    true,
 							},
+							"web_search": schema.BoolAttribute{
+								Description: "Whether the model can search the web.",
+								Computed:    true,
+							},
+							"image_generation": schema.BoolAttribute{
+								Description: "Whether the model can generate images.",
+								Computed:    true,
+							},
+							"code_interpreter": schema.BoolAttribute{
+								Description: "Whether the model can run code in the code interpreter.",
+								Computed:    true,
+							},
+							"file_upload": schema.BoolAttribute{
+								Description: "Whether files can be uploaded to the model.",
+								Computed:    true,
+							},
 						},
 					},
 					"tags": schema.ListNestedAttribute{
@@ -163,13 +227,62 @@ func (d *ModelDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 							},
 						},
 					},
+					"default_features": schema.SingleNestedAttribute{
+						Description: "Features enabled by default in new chats with the model.",
+						Computed:    true,
+						Attributes: map[string]schema.Attribute{
+							"web_search": schema.BoolAttribute{
+								Description: "Whether web search is enabled by default.",
+								Computed:    true,
+							},
+							"image_generation": schema.BoolAttribute{
+								Description: "Whether image generation is enabled by default.",
+								Computed:    true,
+							},
+							"code_interpreter": schema.BoolAttribute{
+								Description: "Whether the code interpreter is enabled by default.",
+								Computed:    true,
+							},
+						},
+					},
+					"suggestion_prompts": schema.ListNestedAttribute{
+						Description: "Starter prompts shown on the new chat screen.",
+						Computed:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"content": schema.StringAttribute{
+									Description: "Prompt sent when the suggestion is selected.",
+									Computed:    true,
+								},
+								"title": schema.ListAttribute{
+									Description: "Title and subtitle of the suggestion.",
+									Computed:    true,
+									ElementType: types.StringType,
+								},
+							},
+						},
+					},
 					"filter_ids": schema.ListAttribute{
 						Description: "List of filter IDs.",
 						Computed:    true,
 						ElementType: types.StringType,
 					},
+					"tool_ids": schema.SetAttribute{
+						Description: "IDs of the tools available to the model.",
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					"knowledge_ids": schema.SetAttribute{
+						Description: "IDs of the knowledge bases the model retrieves from. Single files attached in the UI are not included.",
+						Computed:    true,
+						ElementType: types.StringType,
+					},
 				},
 			},
+			"meta_json": schema.StringAttribute{
+				Description: "JSON object of the meta fields that are not modeled by the provider.",
+				Computed:    true,
+			},
 			"access_control": schema.SingleNestedAttribute{
 				Description: "Access control settings.",
 				Computed:    true,
@@ -233,25 +346,16 @@ func (d *ModelDataSource) Configure(_ context.Context, req datasource.ConfigureR
 		return
 	}
 
-	clients, ok := req.ProviderData.(map[string]interface{})
+	clients, ok := req.ProviderData.(*ProviderClients)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	client, ok := clients["models"].(*models.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *models.Client, got: %T. Please report this issue to the provider developers.", clients["models"]),
-		)
-		return
-	}
-
-	d.client = client
+	d.client = clients.Models
 }
 
 func (d *ModelDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -262,20 +366,56 @@ func (d *ModelDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	// Get specific model
-	foundModel, err := d.client.GetModel(config.ID.ValueString())
+	if config.ID.IsNull() == config.Name.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid search criteria",
+			"Exactly one of id or name must be provided",
+		)
+		return
+	}
+
+	if !config.ID.IsNull() {
+		foundModel, err := d.client.GetModel(ctx, config.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading model", err.Error())
+			return
+		}
+		diags = resp.State.Set(ctx, foundModel)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	list, err := d.client.GetModels(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading model", err.Error())
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to list models, got error: %s", err))
 		return
 	}
-	diags = resp.State.Set(ctx, foundModel)
-	resp.Diagnostics.Append(diags...)
 
-	if foundModel == nil {
+	var matches []models.Model
+	var ids []string
+	for _, model := range list {
+		if model.Name.ValueString() == config.Name.ValueString() {
+			matches = append(matches, model)
+			ids = append(ids, model.ID.ValueString())
+		}
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError(
+			"Model Not Found",
+			fmt.Sprintf("No model found with name: %s", config.Name.ValueString()),
+		)
+		return
+	}
+	if len(matches) > 1 {
 		resp.Diagnostics.AddError(
-			"Error reading model",
-			fmt.Sprintf("No model found with name: %s", config.ID.ValueString()),
+			"Multiple Models Found",
+			fmt.Sprintf("Found %d models with name %s (IDs: %s). Rename them so that the name is unique, or look the model up by id.",
+				len(matches), config.Name.ValueString(), strings.Join(ids, ", ")),
 		)
 		return
 	}
+
+	diags = resp.State.Set(ctx, &matches[0])
+	resp.Diagnostics.Append(diags...)
 }
@@ -0,0 +1,201 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/models"
+)
+
+var (
+	_ datasource.DataSource = &ModelsDataSource{}
+)
+
+type ModelsDataSourceModel struct {
+	Tag         types.String      `tfsdk:"tag"`
+	BaseModelID types.String      `tfsdk:"base_model_id"`
+	IsActive    types.Bool        `tfsdk:"is_active"`
+	Models      []ModelEntryModel `tfsdk:"models"`
+}
+
+// ModelEntryModel describes a single model in the list.
+type ModelEntryModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	BaseModelID types.String `tfsdk:"base_model_id"`
+	Description types.String `tfsdk:"description"`
+	Tags        types.List   `tfsdk:"tags"`
+	IsActive    types.Bool   `tfsdk:"is_active"`
+	IsPrivate   types.Bool   `tfsdk:"is_private"`
+	CreatedAt   types.Int64  `tfsdk:"created_at"`
+	UpdatedAt   types.Int64  `tfsdk:"updated_at"`
+}
+
+func NewModelsDataSource() datasource.DataSource {
+	return &ModelsDataSource{}
+}
+
+type ModelsDataSource struct {
+	client *models.Client
+}
+
+func (d *ModelsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_models"
+}
+
+func (d *ModelsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists workspace models, optionally filtered by tag, base model, and active state.",
+		Attributes: map[string]schema.Attribute{
+			"tag": schema.StringAttribute{
+				Description: "Only return models with this tag.",
+				Optional:    true,
+			},
+			"base_model_id": schema.StringAttribute{
+				Description: "Only return models built on this base model.",
+				Optional:    true,
+			},
+			"is_active": schema.BoolAttribute{
+				Description: "Only return models that are active (true) or inactive (false).",
+				Optional:    true,
+			},
+			"models": schema.ListNestedAttribute{
+				Description: "The matching models.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the model.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the model.",
+							Computed:    true,
+						},
+						"base_model_id": schema.StringAttribute{
+							Description: "The ID of the base model.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the model.",
+							Computed:    true,
+						},
+						"tags": schema.ListAttribute{
+							Description: "Names of the model's tags.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"is_active": schema.BoolAttribute{
+							Description: "Whether the model is active.",
+							Computed:    true,
+						},
+						"is_private": schema.BoolAttribute{
+							Description: "Whether the model is private.",
+							Computed:    true,
+						},
+						"created_at": schema.Int64Attribute{
+							Description: "Timestamp when the model was created.",
+							Computed:    true,
+						},
+						"updated_at": schema.Int64Attribute{
+							Description: "Timestamp when the model was last updated.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ModelsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = clients.Models
+}
+
+func (d *ModelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ModelsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := d.client.GetModels(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to list models, got error: %s", err))
+		return
+	}
+
+	config.Models = make([]ModelEntryModel, 0, len(list))
+	for _, model := range list {
+		if !config.BaseModelID.IsNull() && model.BaseModelID.ValueString() != config.BaseModelID.ValueString() {
+			continue
+		}
+		if !config.IsActive.IsNull() && model.IsActive.ValueBool() != config.IsActive.ValueBool() {
+			continue
+		}
+
+		description := types.StringNull()
+		tags := []string{}
+		if model.Meta != nil {
+			description = model.Meta.Description
+			for _, tag := range model.Meta.Tags {
+				tags = append(tags, tag.Name.ValueString())
+			}
+		}
+		if !config.Tag.IsNull() && !containsString(tags, config.Tag.ValueString()) {
+			continue
+		}
+
+		tagList, diags := types.ListValueFrom(ctx, types.StringType, tags)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		config.Models = append(config.Models, ModelEntryModel{
+			ID:          model.ID,
+			Name:        model.Name,
+			BaseModelID: model.BaseModelID,
+			Description: description,
+			Tags:        tagList,
+			IsActive:    model.IsActive,
+			IsPrivate:   model.IsPrivate,
+			CreatedAt:   model.CreatedAt,
+			UpdatedAt:   model.UpdatedAt,
+		})
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// containsString reports whether list contains value.
+func containsString(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
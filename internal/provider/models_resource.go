@@ -5,10 +5,22 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"os"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,13 +30,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 
-	"github.com/coalition-sre/terraform-provider-openwebui/internal/provider/client/models"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/models"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/system"
 )
 
 var (
-	_ resource.Resource                = &ModelResource{}
-	_ resource.ResourceWithImportState = &ModelResource{}
+	_ resource.Resource                 = &ModelResource{}
+	_ resource.ResourceWithImportState  = &ModelResource{}
+	_ resource.ResourceWithUpgradeState = &ModelResource{}
+	_ resource.ResourceWithModifyPlan   = &ModelResource{}
 )
 
 func NewModelResource() resource.Resource {
@@ -32,7 +49,26 @@ func NewModelResource() resource.Resource {
 }
 
 type ModelResource struct {
-	client *models.Client
+	client  *models.Client
+	version *system.Version
+}
+
+// modelVersionGates lists the model attributes that older OpenWebUI releases do not understand.
+var modelVersionGates = []versionGate{
+	{path: path.Root("params").AtName("function_calling"), minimum: "0.5.0"},
+	{path: path.Root("meta").AtName("capabilities").AtName("web_search"), minimum: "0.6.6"},
+	{path: path.Root("meta").AtName("capabilities").AtName("image_generation"), minimum: "0.6.6"},
+	{path: path.Root("meta").AtName("capabilities").AtName("code_interpreter"), minimum: "0.6.6"},
+	{path: path.Root("meta").AtName("capabilities").AtName("file_upload"), minimum: "0.6.6"},
+	{path: path.Root("meta").AtName("default_features"), minimum: "0.6.6"},
+}
+
+// ModelResourceModel extends the client model with resource-only settings.
+type ModelResourceModel struct {
+	models.Model
+	LockOnUpdatedAt    types.Bool   `tfsdk:"lock_on_updated_at"`
+	ProfileImagePath   types.String `tfsdk:"profile_image_path"`
+	ProfileImageSHA256 types.String `tfsdk:"profile_image_sha256"`
 }
 
 func (r *ModelResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -44,30 +80,23 @@ func (r *ModelResource) Configure(_ context.Context, req resource.ConfigureReque
 		return
 	}
 
-	clients, ok := req.ProviderData.(map[string]interface{})
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
-	}
-
-	client, ok := clients["models"].(*models.Client)
+	clients, ok := req.ProviderData.(*ProviderClients)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *models.Client, got: %T. Please report this issue to the provider developers.", clients["models"]),
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = clients.Models
+	r.version = clients.Version
 }
 
 func (r *ModelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a model in OpenWebUI.",
+		Version:     1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The ID of the model.",
@@ -99,12 +128,24 @@ func (r *ModelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					"min_p":             types.Float64Type,
 					"max_tokens":        types.Int64Type,
 					"seed":              types.Int64Type,
-					"frequency_penalty": types.Int64Type,
+					"frequency_penalty": types.Float64Type,
 					"repeat_last_n":     types.Int64Type,
 					"num_ctx":           types.Int64Type,
 					"num_batch":         types.Int64Type,
 					"num_keep":          types.Int64Type,
 					"function_calling":  types.StringType,
+					"stop":              types.ListType{ElemType: types.StringType},
+					"logit_bias":        types.MapType{ElemType: types.Int64Type},
+					"mirostat":          types.Int64Type,
+					"mirostat_eta":      types.Float64Type,
+					"mirostat_tau":      types.Float64Type,
+					"num_gpu":           types.Int64Type,
+					"num_thread":        types.Int64Type,
+					"repeat_penalty":    types.Float64Type,
+					"tfs_z":             types.Float64Type,
+					"use_mmap":          types.BoolType,
+					"use_mlock":         types.BoolType,
+					"presence_penalty":  types.Float64Type,
 				}, map[string]attr.Value{
 					"system":            types.StringNull(),
 					"stream_response":   types.BoolNull(),
@@ -115,12 +156,24 @@ func (r *ModelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					"min_p":             types.Float64Null(),
 					"max_tokens":        types.Int64Null(),
 					"seed":              types.Int64Null(),
-					"frequency_penalty": types.Int64Null(),
+					"frequency_penalty": types.Float64Null(),
 					"repeat_last_n":     types.Int64Null(),
 					"num_ctx":           types.Int64Null(),
 					"num_batch":         types.Int64Null(),
 					"num_keep":          types.Int64Null(),
 					"function_calling":  types.StringNull(),
+					"stop":              types.ListNull(types.StringType),
+					"logit_bias":        types.MapNull(types.Int64Type),
+					"mirostat":          types.Int64Null(),
+					"mirostat_eta":      types.Float64Null(),
+					"mirostat_tau":      types.Float64Null(),
+					"num_gpu":           types.Int64Null(),
+					"num_thread":        types.Int64Null(),
+					"repeat_penalty":    types.Float64Null(),
+					"tfs_z":             types.Float64Null(),
+					"use_mmap":          types.BoolNull(),
+					"use_mlock":         types.BoolNull(),
+					"presence_penalty":  types.Float64Null(),
 				})),
 				Attributes: map[string]schema.Attribute{
 					"system": schema.StringAttribute{
@@ -132,8 +185,11 @@ func (r *ModelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 						Optional:    true,
 					},
 					"temperature": schema.Float64Attribute{
-						Description: "Sampling temperature.",
+						Description: "Sampling temperature, between 0 and 2.",
 						Optional:    true,
+						Validators: []validator.Float64{
+							float64validator.Between(0, 2),
+						},
 					},
 					"reasoning_effort": schema.StringAttribute{
 						Description: "Reasoning effort level. If set, must be one of: 'low', 'medium', 'high'.",
@@ -143,50 +199,148 @@ func (r *ModelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 						},
 					},
 					"top_p": schema.Float64Attribute{
-						Description: "Top-p sampling parameter.",
+						Description: "Top-p sampling parameter, between 0 and 1.",
 						Optional:    true,
+						Validators: []validator.Float64{
+							float64validator.Between(0, 1),
+						},
 					},
 					"top_k": schema.Int64Attribute{
-						Description: "Top-k sampling parameter.",
+						Description: "Top-k sampling parameter, 0 or more.",
 						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
 					},
 					"min_p": schema.Float64Attribute{
-						Description: "Minimum probability threshold.",
+						Description: "Minimum probability threshold, between 0 and 1.",
 						Optional:    true,
+						Validators: []validator.Float64{
+							float64validator.Between(0, 1),
+						},
 					},
 					"max_tokens": schema.Int64Attribute{
-						Description: "Maximum number of tokens to generate.",
+						Description: "Maximum number of tokens to generate, at least 1.",
 						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
 					},
 					"seed": schema.Int64Attribute{
 						Description: "Random seed for reproducibility.",
 						Optional:    true,
 					},
-					"frequency_penalty": schema.Int64Attribute{
-						Description: "Frequency penalty.",
+					"frequency_penalty": schema.Float64Attribute{
+						Description: "Frequency penalty, between -2 and 2.",
 						Optional:    true,
+						Validators: []validator.Float64{
+							float64validator.Between(-2, 2),
+						},
 					},
 					"repeat_last_n": schema.Int64Attribute{
-						Description: "Number of tokens to consider for repetition penalty.",
+						Description: "Number of tokens to consider for repetition penalty. 0 disables it and -1 uses the context window size.",
 						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(-1),
+						},
 					},
 					"num_ctx": schema.Int64Attribute{
-						Description: "Context window size.",
+						Description: "Context window size, at least 1.",
 						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
 					},
 					"num_batch": schema.Int64Attribute{
-						Description: "Batch size for processing.",
+						Description: "Batch size for processing, at least 1.",
 						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
 					},
 					"num_keep": schema.Int64Attribute{
 						Description: "Number of tokens to keep from prompt.",
 						Optional:    true,
 					},
 					"function_calling": schema.StringAttribute{
-						Description: "Enables function calling support; set to 'native' for API native support, otherwise omit.",
+						Description: "Function calling mode: 'native' uses the model's native tool calling, 'default' lets OpenWebUI handle tool calls. Omit to use the server default.",
 						Optional:    true,
 						Validators: []validator.String{
-							stringvalidator.OneOf("native"),
+							stringvalidator.OneOf("default", "native"),
+						},
+					},
+					"stop": schema.ListAttribute{
+						Description: "Sequences that stop the generation when encountered.",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"logit_bias": schema.MapAttribute{
+						Description: "Bias added to the likelihood of tokens, keyed by token ID. Values range from -100 (ban) to 100 (force).",
+						Optional:    true,
+						ElementType: types.Int64Type,
+						Validators: []validator.Map{
+							mapvalidator.ValueInt64sAre(int64validator.Between(-100, 100)),
+						},
+					},
+					"mirostat": schema.Int64Attribute{
+						Description: "Mirostat sampling mode for Ollama models: 0 disables it, 1 selects Mirostat and 2 Mirostat 2.0.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.Between(0, 2),
+						},
+					},
+					"mirostat_eta": schema.Float64Attribute{
+						Description: "Mirostat learning rate, 0 or more.",
+						Optional:    true,
+						Validators: []validator.Float64{
+							float64validator.AtLeast(0),
+						},
+					},
+					"mirostat_tau": schema.Float64Attribute{
+						Description: "Mirostat target entropy, 0 or more.",
+						Optional:    true,
+						Validators: []validator.Float64{
+							float64validator.AtLeast(0),
+						},
+					},
+					"num_gpu": schema.Int64Attribute{
+						Description: "Number of layers to offload to the GPU.",
+						Optional:    true,
+					},
+					"num_thread": schema.Int64Attribute{
+						Description: "Number of CPU threads used for generation, 0 or more. 0 lets Ollama detect the number of threads.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"repeat_penalty": schema.Float64Attribute{
+						Description: "Penalty applied to repeated tokens, 0 or more.",
+						Optional:    true,
+						Validators: []validator.Float64{
+							float64validator.AtLeast(0),
+						},
+					},
+					"tfs_z": schema.Float64Attribute{
+						Description: "Tail free sampling value, 0 or more. 1 disables it.",
+						Optional:    true,
+						Validators: []validator.Float64{
+							float64validator.AtLeast(0),
+						},
+					},
+					"use_mmap": schema.BoolAttribute{
+						Description: "Whether to memory-map the model.",
+						Optional:    true,
+					},
+					"use_mlock": schema.BoolAttribute{
+						Description: "Whether to lock the model in memory.",
+						Optional:    true,
+					},
+					"presence_penalty": schema.Float64Attribute{
+						Description: "Presence penalty, between -2 and 2.",
+						Optional:    true,
+						Validators: []validator.Float64{
+							float64validator.Between(-2, 2),
 						},
 					},
 				},
@@ -212,14 +366,44 @@ func (r *ModelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 							"vision": schema.BoolAttribute{
 								Description: "Whether the model supports vision tasks.",
 								Optional:    true,
+								Computed:    true,
+								Default:     booldefault.StaticBool(false),
 							},
 							"usage": schema.BoolAttribute{
 								Description: "Whether to track usage statistics.",
 								Optional:    true,
+								Computed:    true,
+								Default:     booldefault.StaticBool(false),
 							},
 							"citations": schema.BoolAttribute{
 								Description: "Whether the model supports citations.",
 								Optional:    true,
+								Computed:    true,
+								Default:     booldefault.StaticBool(false),
+							},
+							"web_search": schema.BoolAttribute{
+								Description: "Whether the model can search the web.",
+								Optional:    true,
+								Computed:    true,
+								Default:     booldefault.StaticBool(false),
+							},
+							"image_generation": schema.BoolAttribute{
+								Description: "Whether the model can generate images.",
+								Optional:    true,
+								Computed:    true,
+								Default:     booldefault.StaticBool(false),
+							},
+							"code_interpreter": schema.BoolAttribute{
+								Description: "Whether the model can run code in the code interpreter.",
+								Optional:    true,
+								Computed:    true,
+								Default:     booldefault.StaticBool(false),
+							},
+							"file_upload": schema.BoolAttribute{
+								Description: "Whether files can be uploaded to the model.",
+								Optional:    true,
+								Computed:    true,
+								Default:     booldefault.StaticBool(false),
 							},
 						},
 					},
@@ -235,11 +419,70 @@ func (r *ModelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 							},
 						},
 					},
+					"default_features": schema.SingleNestedAttribute{
+						Description: "Features enabled by default in new chats with the model.",
+						Optional:    true,
+						Attributes: map[string]schema.Attribute{
+							"web_search": schema.BoolAttribute{
+								Description: "Whether web search is enabled by default.",
+								Optional:    true,
+								Computed:    true,
+								Default:     booldefault.StaticBool(false),
+							},
+							"image_generation": schema.BoolAttribute{
+								Description: "Whether image generation is enabled by default.",
+								Optional:    true,
+								Computed:    true,
+								Default:     booldefault.StaticBool(false),
+							},
+							"code_interpreter": schema.BoolAttribute{
+								Description: "Whether the code interpreter is enabled by default.",
+								Optional:    true,
+								Computed:    true,
+								Default:     booldefault.StaticBool(false),
+							},
+						},
+					},
+					"suggestion_prompts": schema.ListNestedAttribute{
+						Description: "Starter prompts shown on the new chat screen.",
+						Optional:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"content": schema.StringAttribute{
+									Description: "Prompt sent when the suggestion is selected.",
+									Required:    true,
+								},
+								"title": schema.ListAttribute{
+									Description: "Title and subtitle of the suggestion.",
+									Optional:    true,
+									ElementType: types.StringType,
+								},
+							},
+						},
+					},
 					"filter_ids": schema.SetAttribute{
 						Description: "List of filter IDs.",
 						Optional:    true,
 						ElementType: types.StringType,
 					},
+					"tool_ids": schema.SetAttribute{
+						Description: "IDs of the tools available to the model.",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"knowledge_ids": schema.SetAttribute{
+						Description: "IDs of the knowledge bases the model retrieves from. Single files attached in the UI are not included and are removed when this changes.",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+				},
+			},
+			"meta_json": schema.StringAttribute{
+				Description: "JSON object of additional meta fields that are not modeled by the provider, e.g. `jsonencode({ position = 1 })`. " +
+					"The fields are merged into `meta` and must not overlap with the modeled ones. Only the fields set here are tracked.",
+				Optional: true,
+				Validators: []validator.String{
+					jsonObjectValidator{reserved: models.MetaFieldNames()},
 				},
 			},
 			"access_control": schema.SingleNestedAttribute{
@@ -303,19 +546,75 @@ func (r *ModelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"profile_image_path": schema.StringAttribute{
+				Description:         "Path to a local PNG or JPEG file used as the model's profile image.",
+				MarkdownDescription: "Path to a local PNG or JPEG file used as the model's profile image. The file is sent as a data URI, so `meta.profile_image_url` is not tracked when this is set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("meta").AtName("profile_image_url")),
+				},
+			},
+			"profile_image_sha256": schema.StringAttribute{
+				Description: "SHA-256 of the profile image set from `profile_image_path`, used to detect changes to the file or the image on the server.",
+				Computed:    true,
+			},
+			"lock_on_updated_at": schema.BoolAttribute{
+				Description:         "Whether updates are aborted when the model was changed outside of Terraform.",
+				MarkdownDescription: "Whether updates are aborted when the model was changed outside of Terraform. When enabled, the server's `updated_at` must match the value in state before an update is applied.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 	}
 }
 
+// ModifyPlan hashes the local profile image so that the plan only shows a change
+// when the file differs from the image on the server.
+func (r *ModelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkVersionGates(ctx, r.version, req.Config, modelVersionGates)...)
+
+	var plan ModelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.ProfileImagePath.IsUnknown() {
+		return
+	}
+
+	if plan.ProfileImagePath.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("profile_image_sha256"), types.StringNull())...)
+		return
+	}
+
+	hash, err := fileSHA256(plan.ProfileImagePath.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("profile_image_path"), "Unable to Read Profile Image", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("profile_image_sha256"), types.StringValue(hash))...)
+	if plan.Meta != nil {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("meta").AtName("profile_image_url"), types.StringNull())...)
+	}
+}
+
 func (r *ModelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan models.Model
+	var plan ModelResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	model, err := r.client.CreateModel(&plan)
+	resp.Diagnostics.Append(setProfileImage(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model, err := r.client.CreateModel(ctx, &plan.Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating model", err.Error())
 		return
@@ -327,20 +626,29 @@ func (r *ModelResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	diags = resp.State.Set(ctx, model)
+	model.MetaJSON = managedJSON(model.MetaJSON, plan.MetaJSON)
+	model.KeepEmptyCollections(&plan.Model)
+	plan.Model = *model
+	trackProfileImage(&plan)
+	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *ModelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state models.Model
+	var state ModelResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	model, err := r.client.GetModel(state.ID.ValueString())
+	model, err := r.client.GetModel(ctx, state.ID.ValueString())
 	if err != nil {
+		if errors.Is(err, apierror.ErrNotFound) {
+			// Deleted outside of Terraform, plan to create it again
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Error reading model", err.Error())
 		return
 	}
@@ -350,19 +658,23 @@ func (r *ModelResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		model.ID = state.ID
 	}
 
-	diags = resp.State.Set(ctx, model)
+	model.MetaJSON = managedJSON(model.MetaJSON, state.MetaJSON)
+	model.KeepEmptyCollections(&state.Model)
+	state.Model = *model
+	trackProfileImage(&state)
+	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *ModelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan models.Model
+	var plan ModelResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var state models.Model
+	var state ModelResourceModel
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -372,7 +684,29 @@ func (r *ModelResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// Ensure we use the existing ID for the update
 	plan.ID = state.ID
 
-	model, err := r.client.UpdateModel(state.ID.ValueString(), &plan)
+	if plan.LockOnUpdatedAt.ValueBool() {
+		current, err := r.client.GetModel(ctx, state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading model", err.Error())
+			return
+		}
+		if current.UpdatedAt.ValueInt64() != state.UpdatedAt.ValueInt64() {
+			resp.Diagnostics.AddError(
+				"Model changed outside of Terraform",
+				fmt.Sprintf("Model %s was updated on the server at %d, but Terraform state records %d. "+
+					"Refresh first (e.g. terraform apply -refresh-only) and review the changes before applying again.",
+					state.ID.ValueString(), current.UpdatedAt.ValueInt64(), state.UpdatedAt.ValueInt64()),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(setProfileImage(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model, err := r.client.UpdateModel(ctx, state.ID.ValueString(), &state.Model, &plan.Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating model", err.Error())
 		return
@@ -383,19 +717,23 @@ func (r *ModelResource) Update(ctx context.Context, req resource.UpdateRequest,
 		model.ID = state.ID
 	}
 
-	diags = resp.State.Set(ctx, model)
+	model.MetaJSON = managedJSON(model.MetaJSON, plan.MetaJSON)
+	model.KeepEmptyCollections(&plan.Model)
+	plan.Model = *model
+	trackProfileImage(&plan)
+	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *ModelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state models.Model
+	var state ModelResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	err := r.client.DeleteModel(state.ID.ValueString())
+	err := r.client.DeleteModel(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting model", err.Error())
 		return
@@ -406,6 +744,34 @@ func (r *ModelResource) ImportState(ctx context.Context, req resource.ImportStat
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *ModelResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 stored params.frequency_penalty as an integer. Integers are
+		// valid floats and everything else is unchanged, so the raw state only
+		// needs to be decoded with the current schema.
+		0: {
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var schemaResp resource.SchemaResponse
+				r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+				stateType := schemaResp.Schema.Type().TerraformType(ctx)
+
+				rawState, err := req.RawState.Unmarshal(stateType)
+				if err != nil {
+					resp.Diagnostics.AddError("Unable to Upgrade State", fmt.Sprintf("Unable to decode the prior model state, got error: %s", err))
+					return
+				}
+
+				upgraded, err := tfprotov6.NewDynamicValue(stateType, rawState)
+				if err != nil {
+					resp.Diagnostics.AddError("Unable to Upgrade State", fmt.Sprintf("Unable to encode the upgraded model state, got error: %s", err))
+					return
+				}
+				resp.DynamicValue = &upgraded
+			},
+		},
+	}
+}
+
 // AccessControlDefaultModifier is a custom plan modifier for the access_control attribute.
 type AccessControlDefaultModifier struct{}
 
@@ -463,3 +829,141 @@ func (m AccessControlDefaultModifier) PlanModifyObject(ctx context.Context, req
 		resp.PlanValue = types.ObjectNull(attributeTypes)
 	}
 }
+
+// managedMetaJSON reduces the extra meta fields returned by the server to the
+// fields set in configured, so fields added in the UI do not cause a diff.
+// configured is returned as is when the server holds the same values.
+func managedJSON(server, configured types.String) types.String {
+	if configured.IsNull() || configured.IsUnknown() {
+		return types.StringNull()
+	}
+
+	var configuredFields, serverFields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(configured.ValueString()), &configuredFields); err != nil {
+		return configured
+	}
+	if !server.IsNull() {
+		if err := json.Unmarshal([]byte(server.ValueString()), &serverFields); err != nil {
+			return configured
+		}
+	}
+
+	managed := make(map[string]json.RawMessage)
+	for key := range configuredFields {
+		if value, ok := serverFields[key]; ok {
+			managed[key] = value
+		}
+	}
+
+	payload, err := json.Marshal(managed)
+	if err != nil {
+		return configured
+	}
+	normalized, err := models.NormalizeJSON(payload)
+	if err != nil {
+		return configured
+	}
+	if expected, err := models.NormalizeJSON([]byte(configured.ValueString())); err == nil && string(expected) == string(normalized) {
+		return configured
+	}
+
+	return types.StringValue(string(normalized))
+}
+
+// jsonObjectValidator validates that a string holds a JSON object without
+// reserved fields, i.e. fields that are managed by their own attribute.
+type jsonObjectValidator struct {
+	reserved map[string]bool
+}
+
+func (v jsonObjectValidator) Description(ctx context.Context) string {
+	return "value must be a JSON object"
+}
+
+func (v jsonObjectValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonObjectValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &object); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON Object",
+			fmt.Sprintf("Expected a JSON object, got error: %s", err),
+		)
+		return
+	}
+
+	for key := range object {
+		if v.reserved[key] {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid JSON Object",
+				fmt.Sprintf("The field %q is managed by its own attribute and cannot be set in JSON.", key),
+			)
+		}
+	}
+}
+
+// setProfileImage sets the profile image URL of the planned model to the
+// contents of profile_image_path, encoded as a data URI.
+func setProfileImage(data *ModelResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if data.ProfileImagePath.IsNull() {
+		return diags
+	}
+
+	content, err := os.ReadFile(data.ProfileImagePath.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("profile_image_path"), "Unable to Read Profile Image", err.Error())
+		return diags
+	}
+
+	contentType := http.DetectContentType(content)
+	if contentType != "image/png" && contentType != "image/jpeg" {
+		diags.AddAttributeError(
+			path.Root("profile_image_path"),
+			"Unsupported Profile Image",
+			fmt.Sprintf("The profile image must be a PNG or JPEG file, got %s.", contentType),
+		)
+		return diags
+	}
+
+	if data.Meta == nil {
+		data.Meta = &models.ModelMeta{}
+	}
+	data.Meta.ProfileImageURL = types.StringValue(fmt.Sprintf("data:%s;base64,%s", contentType, base64.StdEncoding.EncodeToString(content)))
+
+	return diags
+}
+
+// trackProfileImage replaces the profile image URL returned by the server with
+// the hash of the image when the image is managed through profile_image_path.
+func trackProfileImage(data *ModelResourceModel) {
+	if data.ProfileImagePath.IsNull() {
+		data.ProfileImageSHA256 = types.StringNull()
+		return
+	}
+
+	var url string
+	if data.Meta != nil {
+		url = data.Meta.ProfileImageURL.ValueString()
+		data.Meta.ProfileImageURL = types.StringNull()
+	}
+
+	// Images changed in the UI may not be data URIs, hash the URL itself then
+	content := []byte(url)
+	if _, encoded, ok := strings.Cut(url, ";base64,"); ok && strings.HasPrefix(url, "data:") {
+		if decoded, err := base64.StdEncoding.DecodeString(encoded); err == nil {
+			content = decoded
+		}
+	}
+
+	hash := sha256.Sum256(content)
+	data.ProfileImageSHA256 = types.StringValue(hex.EncodeToString(hash[:]))
+}
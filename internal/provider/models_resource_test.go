@@ -0,0 +1,28 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// The API reports every capability, so capabilities left out of the
+// configuration must be planned as false rather than null.
+func TestModelCapabilitiesDefaultToFalse(t *testing.T) {
+	resp := &resource.SchemaResponse{}
+	NewModelResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	meta := resp.Schema.Attributes["meta"].(schema.SingleNestedAttribute)
+	capabilities := meta.Attributes["capabilities"].(schema.SingleNestedAttribute)
+	for name, attribute := range capabilities.Attributes {
+		capability := attribute.(schema.BoolAttribute)
+		if !capability.Computed || capability.Default == nil {
+			t.Errorf("capability %s has no default", name)
+		}
+	}
+}
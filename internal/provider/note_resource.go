@@ -0,0 +1,291 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/notes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &NoteResource{}
+var _ resource.ResourceWithImportState = &NoteResource{}
+
+func NewNoteResource() resource.Resource {
+	return &NoteResource{}
+}
+
+// NoteResource defines the resource implementation.
+type NoteResource struct {
+	client *notes.Client
+}
+
+// NoteResourceModel describes the resource data model.
+type NoteResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Title         types.String `tfsdk:"title"`
+	Content       types.String `tfsdk:"content"`
+	IsPrivate     types.Bool   `tfsdk:"is_private"`
+	AccessControl types.Object `tfsdk:"access_control"`
+	UserID        types.String `tfsdk:"user_id"`
+	CreatedAt     types.Int64  `tfsdk:"created_at"`
+	UpdatedAt     types.Int64  `tfsdk:"updated_at"`
+}
+
+func (r *NoteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_note"
+}
+
+func (r *NoteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Note resource for OpenWebUI. Notes are markdown documents in the notes workspace, e.g. runbooks or onboarding guides. " +
+			"Notes are owned by a user, so they are created for the user the provider token was issued for. Requires an OpenWebUI version with notes, and notes enabled for the user",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Note identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Title of the note",
+			},
+			"content": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Markdown content of the note, e.g. `file(\"notes/onboarding.md\")`",
+			},
+			"is_private": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the note is private. `access_control` must be unset when this is set to `false`",
+			},
+			"access_control": accessControlResourceAttribute("note"),
+			"user_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the user who owns the note",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp when the note was created",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp when the note was last updated",
+			},
+		},
+	}
+}
+
+func (r *NoteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Notes
+}
+
+func (r *NoteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NoteResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	form, diags := noteForm(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	note, err := r.client.Create(ctx, form)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to create note, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setNoteState(ctx, note, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NoteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NoteResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	note, err := r.client.Get(ctx, data.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, apierror.ErrNotFound) {
+			// Deleted outside of Terraform, plan to create it again
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read note, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setNoteState(ctx, note, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NoteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state NoteResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	form, diags := noteForm(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	note, err := r.client.Update(ctx, state.ID.ValueString(), form)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update note, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setNoteState(ctx, note, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NoteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NoteResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Delete(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete note, got error: %s", err))
+		return
+	}
+}
+
+func (r *NoteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// noteForm converts the planned resource data into the API payload.
+func noteForm(ctx context.Context, data *NoteResourceModel) (*notes.NoteForm, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	form := &notes.NoteForm{
+		Title: data.Title.ValueString(),
+		Data: &notes.NoteData{
+			Content: notes.NoteContent{Markdown: data.Content.ValueString()},
+		},
+	}
+
+	// Public notes have no access control at all
+	if !data.IsPrivate.ValueBool() || data.AccessControl.IsNull() || data.AccessControl.IsUnknown() {
+		return form, diags
+	}
+
+	read, write, d := accessControlFromObject(ctx, data.AccessControl)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	form.AccessControl = &notes.AccessControl{
+		Read:  notes.AccessGroup{GroupIDs: read.GroupIDs, UserIDs: read.UserIDs},
+		Write: notes.AccessGroup{GroupIDs: write.GroupIDs, UserIDs: write.UserIDs},
+	}
+
+	return form, diags
+}
+
+// setNoteState copies the server representation of a note into the resource data.
+func setNoteState(ctx context.Context, note *notes.Note, data *NoteResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(note.ID)
+	data.Title = types.StringValue(note.Title)
+	data.UserID = types.StringValue(note.UserID)
+	data.CreatedAt = types.Int64Value(note.CreatedAt)
+	data.UpdatedAt = types.Int64Value(note.UpdatedAt)
+
+	data.Content = types.StringValue("")
+	if note.Data != nil {
+		data.Content = types.StringValue(note.Data.Content.Markdown)
+	}
+
+	if note.AccessControl == nil {
+		data.IsPrivate = types.BoolValue(false)
+		data.AccessControl = types.ObjectNull(accessControlAttrTypes)
+		return diags
+	}
+
+	ac := note.AccessControl
+	data.IsPrivate = types.BoolValue(true)
+	accessControl, d := accessControlObject(ctx,
+		accessGroup{GroupIDs: ac.Read.GroupIDs, UserIDs: ac.Read.UserIDs},
+		accessGroup{GroupIDs: ac.Write.GroupIDs, UserIDs: ac.Write.UserIDs},
+	)
+	diags.Append(d...)
+	data.AccessControl = accessControl
+
+	return diags
+}
@@ -0,0 +1,228 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/configs"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &OAuthConfigResource{}
+var _ resource.ResourceWithImportState = &OAuthConfigResource{}
+
+func NewOAuthConfigResource() resource.Resource {
+	return &OAuthConfigResource{}
+}
+
+// OAuthConfigResource defines the resource implementation.
+type OAuthConfigResource struct {
+	client *configs.Client
+}
+
+// OAuthConfigResourceModel describes the resource data model.
+type OAuthConfigResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	ClientID              types.String `tfsdk:"client_id"`
+	ClientSecret          types.String `tfsdk:"client_secret"`
+	ProviderURL           types.String `tfsdk:"provider_url"`
+	ProviderName          types.String `tfsdk:"provider_name"`
+	Scopes                types.String `tfsdk:"scopes"`
+	GroupsClaim           types.String `tfsdk:"groups_claim"`
+	EnableGroupManagement types.Bool   `tfsdk:"enable_group_management"`
+	MergeAccountsByEmail  types.Bool   `tfsdk:"merge_accounts_by_email"`
+	EnableSignup          types.Bool   `tfsdk:"enable_signup"`
+}
+
+func (r *OAuthConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oauth_config"
+}
+
+func (r *OAuthConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages single sign-on through an OpenID Connect provider. Requires an admin token. " +
+			"OpenWebUI has no API for these settings, so they are changed by exporting and importing the full configuration of the instance. " +
+			"OpenWebUI registers OAuth providers on startup, so it must be restarted for changes to the client or provider to take effect. " +
+			"Settings that are not configured keep their current value. Destroying the resource leaves the settings unchanged",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Always `oauth`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Client ID registered with the OpenID Connect provider",
+			},
+			"client_secret": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Client secret registered with the OpenID Connect provider",
+			},
+			"provider_url": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "URL of the OpenID Connect discovery document, e.g. `https://login.example.com/.well-known/openid-configuration`",
+			},
+			"provider_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Name of the provider shown on the sign in button",
+			},
+			"scopes": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Space separated scopes requested from the provider, e.g. `openid email profile groups`",
+			},
+			"groups_claim": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Claim of the ID token holding the groups of a user",
+			},
+			"enable_group_management": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether group memberships are synchronized from `groups_claim` on every sign in",
+			},
+			"merge_accounts_by_email": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether an OAuth sign in is linked to an existing account with the same email address",
+			},
+			"enable_signup": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether accounts are created for OAuth users signing in for the first time",
+			},
+		},
+	}
+}
+
+func (r *OAuthConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Configs
+}
+
+func (r *OAuthConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OAuthConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *OAuthConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OAuthConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.GetOAuthConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read OAuth config, got error: %s", err))
+		return
+	}
+
+	setOAuthConfigState(config, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OAuthConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OAuthConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *OAuthConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The settings always exist, so there is nothing to delete
+}
+
+func (r *OAuthConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply sends the configured settings and stores the resulting configuration in state.
+func (r *OAuthConfigResource) apply(ctx context.Context, data *OAuthConfigResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	config, err := r.client.UpdateOAuthConfig(ctx, &configs.OAuthConfig{
+		EnableSignup:         knownBool(data.EnableSignup),
+		MergeAccountsByEmail: knownBool(data.MergeAccountsByEmail),
+		EnableGroupMapping:   knownBool(data.EnableGroupManagement),
+		OIDC: &configs.OIDCConfig{
+			ClientID:     knownString(data.ClientID),
+			ClientSecret: knownString(data.ClientSecret),
+			ProviderURL:  knownString(data.ProviderURL),
+			ProviderName: knownString(data.ProviderName),
+			Scopes:       knownString(data.Scopes),
+			GroupClaim:   knownString(data.GroupsClaim),
+		},
+	})
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update OAuth config, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("oauth")
+	setOAuthConfigState(config, data)
+
+	// Save data into Terraform state
+	diags.Append(state.Set(ctx, data)...)
+}
+
+// setOAuthConfigState copies the server representation of the settings into
+// the resource data. The client secret is only read when it is configured.
+func setOAuthConfigState(config *configs.OAuthConfig, data *OAuthConfigResourceModel) {
+	data.ClientID = types.StringPointerValue(config.OIDC.ClientID)
+	if !data.ClientSecret.IsNull() {
+		data.ClientSecret = types.StringPointerValue(config.OIDC.ClientSecret)
+	}
+	data.ProviderURL = types.StringPointerValue(config.OIDC.ProviderURL)
+	data.ProviderName = types.StringPointerValue(config.OIDC.ProviderName)
+	data.Scopes = types.StringPointerValue(config.OIDC.Scopes)
+	data.GroupsClaim = types.StringPointerValue(config.OIDC.GroupClaim)
+	data.EnableGroupManagement = types.BoolPointerValue(config.EnableGroupMapping)
+	data.MergeAccountsByEmail = types.BoolPointerValue(config.MergeAccountsByEmail)
+	data.EnableSignup = types.BoolPointerValue(config.EnableSignup)
+}
@@ -0,0 +1,357 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/pipelines"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &PipelineResource{}
+
+func NewPipelineResource() resource.Resource {
+	return &PipelineResource{}
+}
+
+// PipelineResource defines the resource implementation.
+type PipelineResource struct {
+	client *pipelines.Client
+}
+
+// PipelineResourceModel describes the resource data model.
+type PipelineResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	PipelinesURL    types.String `tfsdk:"pipelines_url"`
+	Filename        types.String `tfsdk:"filename"`
+	Content         types.String `tfsdk:"content"`
+	Valves          types.Map    `tfsdk:"valves"`
+	SensitiveValves types.Map    `tfsdk:"sensitive_valves"`
+	Name            types.String `tfsdk:"name"`
+	Type            types.String `tfsdk:"type"`
+}
+
+func (r *PipelineResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipeline"
+}
+
+func (r *PipelineResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Pipeline resource for OpenWebUI. Uploads a pipeline to a Pipelines server that is configured as an OpenAI API connection, and sets its valves. " +
+			"Requires an admin token. The Pipelines server does not return the source of a pipeline, so changes to it outside of Terraform are not detected",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the pipeline, which is `filename` without the `.py` extension",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pipelines_url": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "URL of the Pipelines server, as configured in the OpenAI API connections, e.g. `http://pipelines:9099`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"filename": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "File name of the pipeline on the Pipelines server, e.g. `rate_limit_filter_pipeline.py`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z0-9_]+\.py$`), "must be a Python file name of letters, digits and underscores"),
+				},
+			},
+			"content": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Python source of the pipeline, e.g. `file(\"pipelines/rate_limit_filter_pipeline.py\")`",
+			},
+			"valves": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				MarkdownDescription: "Valve values of the pipeline. Values are converted to the type of the valve, so numbers, booleans and lists are written as JSON, e.g. `\"60\"` or `jsonencode([\"*\"])`. " +
+					"Valves that are not set keep their current value and are not tracked. Use `sensitive_valves` for secrets",
+			},
+			"sensitive_valves": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Valve values that are hidden in the plan output, e.g. API keys. Values are converted like `valves`, and a valve cannot be in both maps",
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Name of the pipeline",
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Type of the pipeline (`pipe`, `manifold` or `filter`)",
+			},
+		},
+	}
+}
+
+func (r *PipelineResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Pipelines
+}
+
+func (r *PipelineResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PipelineResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(strings.TrimSuffix(data.Filename.ValueString(), ".py"))
+
+	urlIdx, err := r.client.FindConnection(ctx, data.PipelinesURL.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to find pipelines server, got error: %s", err))
+		return
+	}
+	if err := r.client.Upload(ctx, urlIdx, data.Filename.ValueString(), strings.NewReader(data.Content.ValueString())); err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to upload pipeline, got error: %s", err))
+		return
+	}
+
+	r.apply(ctx, urlIdx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		r.rollbackCreate(ctx, urlIdx, &data, resp)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PipelineResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PipelineResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	urlIdx, err := r.client.FindConnection(ctx, data.PipelinesURL.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to find pipelines server, got error: %s", err))
+		return
+	}
+
+	if err := r.read(ctx, urlIdx, &data, &resp.Diagnostics); err != nil {
+		if errors.Is(err, apierror.ErrNotFound) {
+			// Deleted outside of Terraform, plan to create it again
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read pipeline, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PipelineResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state PipelineResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	urlIdx, err := r.client.FindConnection(ctx, data.PipelinesURL.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to find pipelines server, got error: %s", err))
+		return
+	}
+	if !data.Content.Equal(state.Content) {
+		if err := r.client.Upload(ctx, urlIdx, data.Filename.ValueString(), strings.NewReader(data.Content.ValueString())); err != nil {
+			resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to upload pipeline, got error: %s", err))
+			return
+		}
+	}
+
+	r.apply(ctx, urlIdx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PipelineResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PipelineResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	urlIdx, err := r.client.FindConnection(ctx, data.PipelinesURL.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to find pipelines server, got error: %s", err))
+		return
+	}
+
+	if err := r.client.Delete(ctx, urlIdx, data.ID.ValueString()); err != nil && !errors.Is(err, apierror.ErrNotFound) {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete pipeline, got error: %s", err))
+		return
+	}
+}
+
+// apply sets the configured valves of the uploaded pipeline and reads the
+// result into data.
+func (r *PipelineResource) apply(ctx context.Context, urlIdx int, data *PipelineResourceModel, diags *diag.Diagnostics) {
+	configured := configuredValves(ctx, data.Valves, data.SensitiveValves, diags)
+	if diags.HasError() {
+		return
+	}
+
+	if len(configured) > 0 {
+		current, err := r.client.GetValves(ctx, urlIdx, data.ID.ValueString())
+		if err != nil {
+			diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read pipeline valves, got error: %s", err))
+			return
+		}
+
+		valves := make(map[string]interface{}, len(configured))
+		for key, value := range configured {
+			valves[key] = valveValue(current[key], value)
+		}
+		if _, err := r.client.UpdateValves(ctx, urlIdx, data.ID.ValueString(), valves); err != nil {
+			diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update pipeline valves, got error: %s", err))
+			return
+		}
+	}
+
+	if err := r.read(ctx, urlIdx, data, diags); err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read pipeline, got error: %s", err))
+	}
+}
+
+// rollbackCreate deletes a pipeline whose creation failed after it was
+// uploaded, e.g. because its valves could not be set. If the cleanup fails
+// too, the pipeline is saved to state so that Terraform marks it as tainted
+// and replaces it on the next apply instead of leaking it.
+func (r *PipelineResource) rollbackCreate(ctx context.Context, urlIdx int, data *PipelineResourceModel, resp *resource.CreateResponse) {
+	err := r.client.Delete(ctx, urlIdx, data.ID.ValueString())
+	if err == nil || errors.Is(err, apierror.ErrNotFound) {
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Error cleaning up pipeline",
+		fmt.Sprintf("Could not delete partially created pipeline %s: %s. "+
+			"The pipeline was saved to state as tainted and will be replaced on the next apply.", data.ID.ValueString(), err),
+	)
+
+	if data.Name.IsUnknown() {
+		data.Name = types.StringNull()
+	}
+	if data.Type.IsUnknown() {
+		data.Type = types.StringNull()
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// read copies the pipeline and the configured valves into data.
+func (r *PipelineResource) read(ctx context.Context, urlIdx int, data *PipelineResourceModel, diags *diag.Diagnostics) error {
+	pipeline, err := r.client.Get(ctx, urlIdx, data.ID.ValueString())
+	if err != nil {
+		return err
+	}
+
+	data.Name = types.StringValue(pipeline.Name)
+	data.Type = types.StringValue(pipeline.Type)
+
+	if data.Valves.IsNull() && data.SensitiveValves.IsNull() {
+		return nil
+	}
+
+	current, err := r.client.GetValves(ctx, urlIdx, data.ID.ValueString())
+	if err != nil {
+		return err
+	}
+
+	// Only track the configured valves
+	diags.Append(setValvesState(ctx, current, &data.Valves, &data.SensitiveValves)...)
+	return nil
+}
+
+// valveValue converts a configured valve value to the type of the current
+// value of the valve: strings are sent as is, everything else is decoded as JSON.
+func valveValue(current interface{}, configured string) interface{} {
+	if _, ok := current.(string); ok || current == nil {
+		return configured
+	}
+
+	var value interface{}
+	if err := json.Unmarshal([]byte(configured), &value); err != nil {
+		// Let the Pipelines server report the invalid value
+		return configured
+	}
+	return value
+}
+
+// valveString converts a valve value into its string notation. configured is
+// returned when it denotes the same value, so formatting does not cause a diff.
+func valveString(value interface{}, configured string) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(configured), &decoded); err == nil {
+		a, _ := json.Marshal(decoded)
+		b, _ := json.Marshal(value)
+		if string(a) == string(b) {
+			return configured
+		}
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return configured
+	}
+	return string(b)
+}
@@ -5,18 +5,25 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/url"
 	"os"
+	"strings"
+	"time"
 
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/transport"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/coalition-sre/terraform-provider-openwebui/internal/provider/client/groups"
-	"github.com/coalition-sre/terraform-provider-openwebui/internal/provider/client/knowledge"
-	"github.com/coalition-sre/terraform-provider-openwebui/internal/provider/client/models"
-	"github.com/coalition-sre/terraform-provider-openwebui/internal/provider/client/users"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
@@ -28,8 +35,23 @@ type OpenWebUIProvider struct {
 }
 
 type OpenWebUIProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	Token    types.String `tfsdk:"token"`
+	Endpoint   types.String `tfsdk:"endpoint"`
+	Token      types.String `tfsdk:"token"`
+	Email      types.String `tfsdk:"email"`
+	Password   types.String `tfsdk:"password"`
+	TokenFile  types.String `tfsdk:"token_file"`
+	MaxRetries types.Int64  `tfsdk:"max_retries"`
+	RetryWait  types.String `tfsdk:"retry_wait"`
+	Timeout    types.String `tfsdk:"timeout"`
+
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ClientCertPEM      types.String `tfsdk:"client_cert_pem"`
+	ClientKeyPEM       types.String `tfsdk:"client_key_pem"`
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+	Headers            types.Map    `tfsdk:"headers"`
+	CompressRequests   types.Bool   `tfsdk:"compress_requests"`
 }
 
 func (p *OpenWebUIProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -46,9 +68,91 @@ func (p *OpenWebUIProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:    true,
 			},
 			"token": schema.StringAttribute{
-				Description: "The token to authenticate with the OpenWebUI API. May also be provided via OPENWEBUI_TOKEN environment variable.",
+				Description: "The token to authenticate with the OpenWebUI API. May also be provided via `token_file` or the OPENWEBUI_TOKEN environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"token_file": schema.StringAttribute{
+				Description: "Path to a file containing the token to authenticate with the OpenWebUI API, e.g. a mounted secret. Surrounding whitespace is ignored.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("token")),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "The email of the user to sign in as instead of using `token`. The provider signs in with `email` and `password` when it is configured and uses the resulting session token. Requires `password`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("password")),
+					stringvalidator.ConflictsWith(path.MatchRoot("token"), path.MatchRoot("token_file")),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: "The password of the user to sign in as. Requires `email`.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("email")),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: fmt.Sprintf("The number of times a request is retried when the OpenWebUI API responds with a 429, 502, 503 or 504 status code. Defaults to %d.", transport.DefaultMaxRetries),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait": schema.StringAttribute{
+				Description: fmt.Sprintf("The initial wait between retries as a duration string, e.g. `500ms` or `2s`. The wait doubles after every attempt and is jittered. Defaults to `%s`.", transport.DefaultRetryWait),
+				Optional:    true,
+			},
+			"timeout": schema.StringAttribute{
+				Description: fmt.Sprintf("The time limit for a request to the OpenWebUI API, including its retries, as a duration string, e.g. `30s` or `10m`. Raise it when uploading large files to knowledge bases. Defaults to `%s`.", transport.DefaultTimeout),
+				Optional:    true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded certificate authorities to trust in addition to the system ones, e.g. when OpenWebUI is served with a certificate from an internal CA.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_file")),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a file with PEM encoded certificate authorities to trust in addition to the system ones.",
+				Optional:    true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Skip the verification of the OpenWebUI server certificate. Only use this for testing.",
+				Optional:    true,
+			},
+			"client_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded client certificate presented to the server, e.g. when OpenWebUI is exposed through an mTLS ingress. Requires `client_key_pem`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key_pem")),
+				},
+			},
+			"client_key_pem": schema.StringAttribute{
+				Description: "PEM encoded private key of the client certificate. Requires `client_cert_pem`.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert_pem")),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of the proxy used to reach the OpenWebUI API, e.g. `http://proxy.internal:3128`. Defaults to the HTTPS_PROXY and HTTP_PROXY environment variables. Hosts listed in NO_PROXY are always reached directly.",
+				Optional:    true,
+			},
+			"headers": schema.MapAttribute{
+				Description: "Additional headers sent with every request, e.g. the `CF-Access-Client-Id` and `CF-Access-Client-Secret` headers required by Cloudflare Access. Headers set by the provider itself, such as `Authorization`, are not overridden.",
 				Optional:    true,
 				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+			"compress_requests": schema.BoolAttribute{
+				Description: "Compress request bodies of 32 KiB or more with gzip, e.g. large model definitions or knowledge file uploads. OpenWebUI does not decompress requests itself, so only enable this when a proxy in front of it does. Responses are always requested with gzip compression.",
+				Optional:    true,
 			},
 		},
 	}
@@ -62,17 +166,23 @@ func (p *OpenWebUIProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
-	if config.Endpoint.IsNull() {
-		endpoint := os.Getenv("OPENWEBUI_ENDPOINT")
-		config.Endpoint = types.StringValue(endpoint)
+	if config.Endpoint.IsUnknown() || config.Token.IsUnknown() || config.TokenFile.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown OpenWebUI API Credentials",
+			"The provider cannot create the OpenWebUI API client as the endpoint or token depends on a value that is not known yet. "+
+				"Set static values in the configuration or use the OPENWEBUI_ENDPOINT and OPENWEBUI_TOKEN environment variables.",
+		)
+		return
 	}
 
-	if config.Token.IsNull() {
-		token := os.Getenv("OPENWEBUI_TOKEN")
-		config.Token = types.StringValue(token)
+	endpointSource := "the endpoint attribute"
+	if config.Endpoint.IsNull() {
+		endpointSource = "the OPENWEBUI_ENDPOINT environment variable"
+		config.Endpoint = types.StringValue(os.Getenv("OPENWEBUI_ENDPOINT"))
 	}
+	config.Endpoint = types.StringValue(strings.TrimRight(config.Endpoint.ValueString(), "/"))
 
-	if config.Endpoint.IsNull() {
+	if config.Endpoint.ValueString() == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("endpoint"),
 			"Missing OpenWebUI API Endpoint",
@@ -80,15 +190,44 @@ func (p *OpenWebUIProvider) Configure(ctx context.Context, req provider.Configur
 				"Set the endpoint value in the configuration or use the OPENWEBUI_ENDPOINT environment variable. "+
 				"If either is already set, ensure the value is not empty.",
 		)
+	} else if u, err := url.Parse(config.Endpoint.ValueString()); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoint"),
+			"Invalid OpenWebUI API Endpoint",
+			fmt.Sprintf("The endpoint taken from %s must be an absolute http or https URL such as \"https://openwebui.example.com\", got: %q", endpointSource, config.Endpoint.ValueString()),
+		)
 	}
 
-	if config.Token.IsNull() {
+	// The token is taken from the first source that is set: the token attribute, the
+	// token_file attribute and the OPENWEBUI_TOKEN environment variable. Signing in with
+	// email and password replaces all of them.
+	tokenSource := "the token attribute"
+	switch {
+	case !config.Email.IsNull():
+		tokenSource = ""
+	case !config.TokenFile.IsNull():
+		tokenSource = fmt.Sprintf("the token_file %s", config.TokenFile.ValueString())
+		token, err := os.ReadFile(config.TokenFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("token_file"),
+				"Unable to Read OpenWebUI API Token File",
+				fmt.Sprintf("Unable to read %s, got error: %s", config.TokenFile.ValueString(), err),
+			)
+			return
+		}
+		config.Token = types.StringValue(strings.TrimSpace(string(token)))
+	case config.Token.IsNull():
+		tokenSource = "the OPENWEBUI_TOKEN environment variable"
+		config.Token = types.StringValue(os.Getenv("OPENWEBUI_TOKEN"))
+	}
+
+	if tokenSource != "" && config.Token.ValueString() == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("token"),
 			"Missing OpenWebUI API Token",
-			"The provider cannot create the OpenWebUI API client as there is a missing or empty value for the OpenWebUI API token. "+
-				"Set the token value in the configuration or use the OPENWEBUI_TOKEN environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+			fmt.Sprintf("The provider cannot create the OpenWebUI API client as the OpenWebUI API token taken from %s is empty. ", tokenSource)+
+				"Set the token or token_file value in the configuration, use the OPENWEBUI_TOKEN environment variable or sign in with email and password.",
 		)
 	}
 
@@ -96,38 +235,185 @@ func (p *OpenWebUIProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
-	// Create new OpenWebUI clients
-	groupsClient := groups.NewClient(config.Endpoint.ValueString(), config.Token.ValueString())
-	knowledgeClient := knowledge.NewClient(config.Endpoint.ValueString(), config.Token.ValueString())
-	modelsClient := models.NewClient(config.Endpoint.ValueString(), config.Token.ValueString())
-	usersClient := users.NewClient(config.Endpoint.ValueString(), config.Token.ValueString())
+	tflog.Debug(ctx, "Configuring OpenWebUI API client", map[string]interface{}{
+		"endpoint":        config.Endpoint.ValueString(),
+		"endpoint_source": endpointSource,
+		"token_source":    tokenSource,
+	})
+
+	transportConfig := transport.Config{
+		MaxRetries:         transport.DefaultMaxRetries,
+		RetryWait:          parseDuration(config.RetryWait, path.Root("retry_wait"), transport.DefaultRetryWait, &resp.Diagnostics),
+		Timeout:            parseDuration(config.Timeout, path.Root("timeout"), transport.DefaultTimeout, &resp.Diagnostics),
+		Headers:            map[string]string{},
+		CACertPEM:          []byte(config.CACertPEM.ValueString()),
+		InsecureSkipVerify: config.InsecureSkipVerify.ValueBool(),
+		ClientCertPEM:      []byte(config.ClientCertPEM.ValueString()),
+		ClientKeyPEM:       []byte(config.ClientKeyPEM.ValueString()),
+		ProxyURL:           config.ProxyURL.ValueString(),
+		CompressRequests:   config.CompressRequests.ValueBool(),
+	}
+	if !config.MaxRetries.IsNull() {
+		transportConfig.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+	if !config.CACertFile.IsNull() {
+		caCertPEM, err := os.ReadFile(config.CACertFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ca_cert_file"),
+				"Unable to Read CA Certificate File",
+				fmt.Sprintf("Unable to read %s, got error: %s", config.CACertFile.ValueString(), err),
+			)
+		}
+		transportConfig.CACertPEM = caCertPEM
+	}
+
+	if !config.Headers.IsNull() {
+		resp.Diagnostics.Append(config.Headers.ElementsAs(ctx, &transportConfig.Headers, false)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// Create a map to store all clients
-	clients := map[string]interface{}{
-		"groups":    groupsClient,
-		"knowledge": knowledgeClient,
-		"models":    modelsClient,
-		"users":     usersClient,
+	httpClient, err := transport.NewClient(transportConfig)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create OpenWebUI API Client",
+			fmt.Sprintf("Unable to configure the HTTP transport, got error: %s", err),
+		)
+		return
+	}
+
+	// Make sure the server is reachable before anything else, so that a wrong
+	// endpoint is reported here rather than by the first resource that is read.
+	client := openwebui.New(config.Endpoint.ValueString(), config.Token.ValueString(), httpClient)
+
+	version, err := client.System.GetVersion(ctx)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoint"),
+			"Unable to Connect to OpenWebUI",
+			fmt.Sprintf("Unable to get the version of the OpenWebUI server at %s, ensure the endpoint points to an OpenWebUI instance. Got error: %s", config.Endpoint.ValueString(), err),
+		)
+		return
+	}
+	tflog.Info(ctx, "Connected to OpenWebUI", map[string]interface{}{
+		"endpoint": config.Endpoint.ValueString(),
+		"version":  version.Version,
+	})
+
+	// Exchange the credentials for a session token. Provider configuration is
+	// never persisted, so the token only lives for the duration of this run.
+	if !config.Email.IsNull() {
+		client, err = client.Signin(ctx, config.Email.ValueString(), config.Password.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("email"),
+				"Unable to Sign In to OpenWebUI",
+				fmt.Sprintf("Unable to sign in as %s on the OpenWebUI %s server at %s, got error: %s", config.Email.ValueString(), version.Version, config.Endpoint.ValueString(), err),
+			)
+			return
+		}
+	} else if _, err := client.Auths.GetSessionUser(ctx); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token"),
+			"Unable to Authenticate with OpenWebUI",
+			fmt.Sprintf("The OpenWebUI %s server at %s rejected the token taken from %s, got error: %s", version.Version, config.Endpoint.ValueString(), tokenSource, err),
+		)
+		return
+	}
+
+	clients := &ProviderClients{
+		Client:  client,
+		Version: version,
 	}
 
 	resp.DataSourceData = clients
 	resp.ResourceData = clients
 }
 
+// parseDuration returns the duration configured in value, or def when it is not set
+func parseDuration(value types.String, attributePath path.Path, def time.Duration, diags *diag.Diagnostics) time.Duration {
+	if value.IsNull() {
+		return def
+	}
+
+	d, err := time.ParseDuration(value.ValueString())
+	if err != nil || d <= 0 {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid Duration",
+			fmt.Sprintf("The %s value must be a positive duration such as \"500ms\" or \"2s\", got: %q", attributePath, value.ValueString()),
+		)
+		return def
+	}
+	return d
+}
+
 func (p *OpenWebUIProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewBaseModelsDataSource,
+		NewChannelDataSource,
+		NewChatsDataSource,
+		NewCurrentUserDataSource,
+		NewEvaluationFeedbackDataSource,
+		NewEvaluationLeaderboardDataSource,
+		NewFileDataSource,
+		NewFolderDataSource,
+		NewFunctionsDataSource,
 		NewGroupDataSource,
+		NewGroupsDataSource,
+		NewKnowledgeBasesDataSource,
 		NewKnowledgeDataSource,
 		NewModelDataSource,
+		NewModelsDataSource,
+		NewToolServersDataSource,
 		NewUserDataSource,
+		NewUsersDataSource,
+		NewVersionDataSource,
 	}
 }
 
 func (p *OpenWebUIProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewAudioConfigResource,
+		NewAuthConfigResource,
+		NewBannerResource,
+		NewChannelResource,
+		NewCodeExecutionConfigResource,
+		NewConfigBaselineResource,
+		NewConnectionResource,
+		NewDefaultModelsResource,
+		NewEvaluationConfigResource,
+		NewFileResource,
+		NewFolderResource,
+		NewFunctionToggleResource,
+		NewFunctionValvesResource,
+		NewGroupMembershipResource,
 		NewGroupResource,
+		NewImageConfigResource,
+		NewInterfaceConfigResource,
+		NewKnowledgeFileResource,
 		NewKnowledgeResource,
+		NewKnowledgeSyncResource,
+		NewLDAPConfigResource,
+		NewMemoryResource,
+		NewModelOrderResource,
 		NewModelResource,
+		NewNoteResource,
+		NewOAuthConfigResource,
+		NewOllamaConnectionResource,
+		NewPipelineResource,
+		NewRAGConfigResource,
+		NewTaskConfigResource,
+		NewToolResource,
+		NewToolValvesResource,
+		NewUserAPIKeyResource,
+		NewUserPermissionsResource,
+		NewUserResource,
+		NewUserSettingsResource,
+		NewWebSearchConfigResource,
 	}
 }
 
@@ -0,0 +1,19 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/system"
+)
+
+// ProviderClients is passed to resources and data sources as ProviderData. It
+// embeds the API clients, e.g. clients.Models, and carries what Configure
+// learned about the server.
+type ProviderClients struct {
+	*openwebui.Client
+
+	// Version is the version of the OpenWebUI server detected by Configure
+	Version *system.Version
+}
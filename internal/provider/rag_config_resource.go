@@ -0,0 +1,352 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/configs"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &RAGConfigResource{}
+var _ resource.ResourceWithImportState = &RAGConfigResource{}
+
+func NewRAGConfigResource() resource.Resource {
+	return &RAGConfigResource{}
+}
+
+// RAGConfigResource defines the resource implementation.
+type RAGConfigResource struct {
+	client *configs.Client
+}
+
+// RAGConfigResourceModel describes the resource data model.
+type RAGConfigResourceModel struct {
+	ID                 types.String  `tfsdk:"id"`
+	EmbeddingEngine    types.String  `tfsdk:"embedding_engine"`
+	EmbeddingModel     types.String  `tfsdk:"embedding_model"`
+	EmbeddingBatchSize types.Int64   `tfsdk:"embedding_batch_size"`
+	EmbeddingOpenAIURL types.String  `tfsdk:"embedding_openai_url"`
+	EmbeddingOpenAIKey types.String  `tfsdk:"embedding_openai_key"`
+	EmbeddingOllamaURL types.String  `tfsdk:"embedding_ollama_url"`
+	EmbeddingOllamaKey types.String  `tfsdk:"embedding_ollama_key"`
+	TextSplitter       types.String  `tfsdk:"text_splitter"`
+	ChunkSize          types.Int64   `tfsdk:"chunk_size"`
+	ChunkOverlap       types.Int64   `tfsdk:"chunk_overlap"`
+	TopK               types.Int64   `tfsdk:"top_k"`
+	HybridSearch       types.Bool    `tfsdk:"hybrid_search"`
+	RerankingModel     types.String  `tfsdk:"reranking_model"`
+	TopKReranker       types.Int64   `tfsdk:"top_k_reranker"`
+	RelevanceThreshold types.Float64 `tfsdk:"relevance_threshold"`
+	FullContext        types.Bool    `tfsdk:"full_context"`
+	Template           types.String  `tfsdk:"template"`
+}
+
+func (r *RAGConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rag_config"
+}
+
+func (r *RAGConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the retrieval augmented generation pipeline used for knowledge bases and attached files: " +
+			"the embedding model, how documents are split into chunks and how chunks are retrieved. Requires an admin token. " +
+			"Changing the embedding model does not re-embed existing documents. " +
+			"Settings that are not configured keep their current value. Destroying the resource leaves the settings unchanged",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Always `rag`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"embedding_engine": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Engine computing the embeddings: empty for the built-in SentenceTransformers, `ollama`, `openai` or `azure_openai`",
+				Validators: []validator.String{
+					stringvalidator.OneOf("", "ollama", "openai", "azure_openai"),
+				},
+			},
+			"embedding_model": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Embedding model, e.g. `sentence-transformers/all-MiniLM-L6-v2` or `text-embedding-3-small`",
+			},
+			"embedding_batch_size": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Number of chunks embedded per request",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"embedding_openai_url": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Base URL of the OpenAI compatible API used by the `openai` engine",
+			},
+			"embedding_openai_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "API key used by the `openai` engine",
+			},
+			"embedding_ollama_url": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Base URL of the Ollama server used by the `ollama` engine",
+			},
+			"embedding_ollama_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "API key used by the `ollama` engine",
+			},
+			"text_splitter": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "How documents are split into chunks: empty or `character` to split by characters, `token` to split by tokens",
+			},
+			"chunk_size": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Maximum size of a chunk",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"chunk_overlap": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Size of the overlap between consecutive chunks",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"top_k": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Number of chunks added to the context of a chat",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"hybrid_search": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether BM25 keyword search is combined with the vector search and the results are reranked",
+			},
+			"reranking_model": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Model reranking the results of a hybrid search, e.g. `BAAI/bge-reranker-v2-m3`",
+			},
+			"top_k_reranker": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Number of chunks kept after reranking",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"relevance_threshold": schema.Float64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Minimum relevance score of a chunk after reranking, between 0 and 1",
+				Validators: []validator.Float64{
+					float64validator.Between(0, 1),
+				},
+			},
+			"full_context": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether whole documents are added to the context instead of the retrieved chunks",
+			},
+			"template": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Prompt template combining the retrieved context with the question of the user",
+			},
+		},
+	}
+}
+
+func (r *RAGConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Configs
+}
+
+func (r *RAGConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RAGConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *RAGConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RAGConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RAGConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RAGConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *RAGConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The settings always exist, so there is nothing to delete
+}
+
+func (r *RAGConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply sends the configured settings and stores the resulting configuration in state.
+func (r *RAGConfigResource) apply(ctx context.Context, data *RAGConfigResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	embedding := &configs.EmbeddingConfig{
+		Engine:    knownString(data.EmbeddingEngine),
+		Model:     knownString(data.EmbeddingModel),
+		BatchSize: knownInt64(data.EmbeddingBatchSize),
+		OpenAIConfig: &configs.EmbeddingEndpointConfig{
+			URL: knownString(data.EmbeddingOpenAIURL),
+			Key: knownString(data.EmbeddingOpenAIKey),
+		},
+		OllamaConfig: &configs.EmbeddingEndpointConfig{
+			URL: knownString(data.EmbeddingOllamaURL),
+			Key: knownString(data.EmbeddingOllamaKey),
+		},
+	}
+
+	// Updating the embedding settings reloads the model, so skip it when
+	// none of them are configured
+	if embedding.Engine != nil || embedding.Model != nil || embedding.BatchSize != nil ||
+		embedding.OpenAIConfig.URL != nil || embedding.OpenAIConfig.Key != nil ||
+		embedding.OllamaConfig.URL != nil || embedding.OllamaConfig.Key != nil {
+		if _, err := r.client.UpdateEmbeddingConfig(ctx, embedding); err != nil {
+			diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update embedding config, got error: %s", err))
+			return
+		}
+	}
+
+	_, err := r.client.UpdateRAGConfig(ctx, &configs.RAGConfig{
+		Template:           knownString(data.Template),
+		TopK:               knownInt64(data.TopK),
+		TopKReranker:       knownInt64(data.TopKReranker),
+		RelevanceThreshold: knownFloat64(data.RelevanceThreshold),
+		EnableHybridSearch: knownBool(data.HybridSearch),
+		RerankingModel:     knownString(data.RerankingModel),
+		FullContext:        knownBool(data.FullContext),
+		TextSplitter:       knownString(data.TextSplitter),
+		ChunkSize:          knownInt64(data.ChunkSize),
+		ChunkOverlap:       knownInt64(data.ChunkOverlap),
+	})
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update RAG config, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("rag")
+	diags.Append(r.read(ctx, data)...)
+	if diags.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags.Append(state.Set(ctx, data)...)
+}
+
+// read copies the current settings into the resource data. API keys are only
+// read when they are configured.
+func (r *RAGConfigResource) read(ctx context.Context, data *RAGConfigResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	embedding, err := r.client.GetEmbeddingConfig(ctx)
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read embedding config, got error: %s", err))
+		return diags
+	}
+
+	config, err := r.client.GetRAGConfig(ctx)
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read RAG config, got error: %s", err))
+		return diags
+	}
+
+	data.EmbeddingEngine = types.StringPointerValue(embedding.Engine)
+	data.EmbeddingModel = types.StringPointerValue(embedding.Model)
+	data.EmbeddingBatchSize = types.Int64PointerValue(embedding.BatchSize)
+	data.EmbeddingOpenAIURL = types.StringPointerValue(embedding.OpenAIConfig.URL)
+	if !data.EmbeddingOpenAIKey.IsNull() {
+		data.EmbeddingOpenAIKey = types.StringPointerValue(embedding.OpenAIConfig.Key)
+	}
+	data.EmbeddingOllamaURL = types.StringPointerValue(embedding.OllamaConfig.URL)
+	if !data.EmbeddingOllamaKey.IsNull() {
+		data.EmbeddingOllamaKey = types.StringPointerValue(embedding.OllamaConfig.Key)
+	}
+
+	data.TextSplitter = types.StringPointerValue(config.TextSplitter)
+	data.ChunkSize = types.Int64PointerValue(config.ChunkSize)
+	data.ChunkOverlap = types.Int64PointerValue(config.ChunkOverlap)
+	data.TopK = types.Int64PointerValue(config.TopK)
+	data.HybridSearch = types.BoolPointerValue(config.EnableHybridSearch)
+	data.RerankingModel = types.StringPointerValue(config.RerankingModel)
+	data.TopKReranker = types.Int64PointerValue(config.TopKReranker)
+	data.RelevanceThreshold = types.Float64PointerValue(config.RelevanceThreshold)
+	data.FullContext = types.BoolPointerValue(config.FullContext)
+	data.Template = types.StringPointerValue(config.Template)
+
+	return diags
+}
@@ -0,0 +1,242 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/configs"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &TaskConfigResource{}
+var _ resource.ResourceWithImportState = &TaskConfigResource{}
+
+func NewTaskConfigResource() resource.Resource {
+	return &TaskConfigResource{}
+}
+
+// TaskConfigResource defines the resource implementation.
+type TaskConfigResource struct {
+	client *configs.Client
+}
+
+// TaskConfigResourceModel describes the resource data model.
+type TaskConfigResourceModel struct {
+	ID                             types.String `tfsdk:"id"`
+	TaskModel                      types.String `tfsdk:"task_model"`
+	TaskModelExternal              types.String `tfsdk:"task_model_external"`
+	EnableTitleGeneration          types.Bool   `tfsdk:"enable_title_generation"`
+	TitleGenerationPromptTemplate  types.String `tfsdk:"title_generation_prompt_template"`
+	EnableTagsGeneration           types.Bool   `tfsdk:"enable_tags_generation"`
+	TagsGenerationPromptTemplate   types.String `tfsdk:"tags_generation_prompt_template"`
+	EnableAutocompleteGeneration   types.Bool   `tfsdk:"enable_autocomplete_generation"`
+	AutocompleteInputMaxLength     types.Int64  `tfsdk:"autocomplete_input_max_length"`
+	EnableSearchQueryGeneration    types.Bool   `tfsdk:"enable_search_query_generation"`
+	EnableRetrievalQueryGeneration types.Bool   `tfsdk:"enable_retrieval_query_generation"`
+	QueryGenerationPromptTemplate  types.String `tfsdk:"query_generation_prompt_template"`
+}
+
+func (r *TaskConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_task_config"
+}
+
+func (r *TaskConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the task models and the background tasks they run, such as generating chat titles, tags and search queries. " +
+			"Requires an admin token. Settings that are not configured keep their current value. Destroying the resource leaves the settings unchanged",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Always `task`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"task_model": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "ID of the model running tasks for chats with local (Ollama) models. An empty string uses the model of the chat",
+			},
+			"task_model_external": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "ID of the model running tasks for chats with external (OpenAI compatible) models. An empty string uses the model of the chat",
+			},
+			"enable_title_generation": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether chat titles are generated",
+			},
+			"title_generation_prompt_template": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Prompt used to generate chat titles. An empty string uses the built-in prompt",
+			},
+			"enable_tags_generation": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether chat tags are generated",
+			},
+			"tags_generation_prompt_template": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Prompt used to generate chat tags. An empty string uses the built-in prompt",
+			},
+			"enable_autocomplete_generation": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether the message input is autocompleted",
+			},
+			"autocomplete_input_max_length": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Maximum length of the input sent for autocompletion. `-1` disables the limit",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(-1),
+				},
+			},
+			"enable_search_query_generation": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether web search queries are generated from the chat",
+			},
+			"enable_retrieval_query_generation": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether knowledge retrieval queries are generated from the chat",
+			},
+			"query_generation_prompt_template": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Prompt used to generate search and retrieval queries. An empty string uses the built-in prompt",
+			},
+		},
+	}
+}
+
+func (r *TaskConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Configs
+}
+
+func (r *TaskConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TaskConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *TaskConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TaskConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.GetTaskConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read task config, got error: %s", err))
+		return
+	}
+
+	setTaskConfigState(config, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TaskConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TaskConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *TaskConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The settings always exist, so there is nothing to delete
+}
+
+func (r *TaskConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply sends the configured settings and stores the resulting configuration in state.
+func (r *TaskConfigResource) apply(ctx context.Context, data *TaskConfigResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	config, err := r.client.UpdateTaskConfig(ctx, &configs.TaskConfig{
+		TaskModel:                      knownString(data.TaskModel),
+		TaskModelExternal:              knownString(data.TaskModelExternal),
+		EnableTitleGeneration:          knownBool(data.EnableTitleGeneration),
+		TitleGenerationPromptTemplate:  knownString(data.TitleGenerationPromptTemplate),
+		EnableTagsGeneration:           knownBool(data.EnableTagsGeneration),
+		TagsGenerationPromptTemplate:   knownString(data.TagsGenerationPromptTemplate),
+		EnableAutocompleteGeneration:   knownBool(data.EnableAutocompleteGeneration),
+		AutocompleteInputMaxLength:     knownInt64(data.AutocompleteInputMaxLength),
+		EnableSearchQueryGeneration:    knownBool(data.EnableSearchQueryGeneration),
+		EnableRetrievalQueryGeneration: knownBool(data.EnableRetrievalQueryGeneration),
+		QueryGenerationPromptTemplate:  knownString(data.QueryGenerationPromptTemplate),
+	})
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update task config, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("task")
+	setTaskConfigState(config, data)
+
+	// Save data into Terraform state
+	diags.Append(state.Set(ctx, data)...)
+}
+
+// setTaskConfigState copies the server representation of the settings into the resource data.
+func setTaskConfigState(config *configs.TaskConfig, data *TaskConfigResourceModel) {
+	data.TaskModel = types.StringPointerValue(config.TaskModel)
+	data.TaskModelExternal = types.StringPointerValue(config.TaskModelExternal)
+	data.EnableTitleGeneration = types.BoolPointerValue(config.EnableTitleGeneration)
+	data.TitleGenerationPromptTemplate = types.StringPointerValue(config.TitleGenerationPromptTemplate)
+	data.EnableTagsGeneration = types.BoolPointerValue(config.EnableTagsGeneration)
+	data.TagsGenerationPromptTemplate = types.StringPointerValue(config.TagsGenerationPromptTemplate)
+	data.EnableAutocompleteGeneration = types.BoolPointerValue(config.EnableAutocompleteGeneration)
+	data.AutocompleteInputMaxLength = types.Int64PointerValue(config.AutocompleteInputMaxLength)
+	data.EnableSearchQueryGeneration = types.BoolPointerValue(config.EnableSearchQueryGeneration)
+	data.EnableRetrievalQueryGeneration = types.BoolPointerValue(config.EnableRetrievalQueryGeneration)
+	data.QueryGenerationPromptTemplate = types.StringPointerValue(config.QueryGenerationPromptTemplate)
+}
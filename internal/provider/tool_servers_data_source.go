@@ -0,0 +1,141 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/configs"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ToolServersDataSource{}
+
+func NewToolServersDataSource() datasource.DataSource {
+	return &ToolServersDataSource{}
+}
+
+// ToolServersDataSource defines the data source implementation.
+type ToolServersDataSource struct {
+	client *configs.Client
+}
+
+// ToolServersDataSourceModel describes the data source data model.
+type ToolServersDataSourceModel struct {
+	ToolServers []ToolServerModel `tfsdk:"tool_servers"`
+}
+
+// ToolServerModel describes a single tool server connection.
+type ToolServerModel struct {
+	Index       types.Int64  `tfsdk:"index"`
+	URL         types.String `tfsdk:"url"`
+	Path        types.String `tfsdk:"path"`
+	AuthType    types.String `tfsdk:"auth_type"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (d *ToolServersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tool_servers"
+}
+
+func (d *ToolServersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the OpenAPI tool servers configured on the OpenWebUI instance. Requires an admin token.",
+
+		Attributes: map[string]schema.Attribute{
+			"tool_servers": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Configured tool server connections, in the order they are stored on the server",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"index": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Position of the connection in the server's connection list",
+						},
+						"url": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Base URL of the tool server",
+						},
+						"path": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Path of the OpenAPI specification relative to the base URL",
+						},
+						"auth_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Authentication type used for the connection (e.g. `bearer`, `session`)",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the connection is enabled",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Display name of the tool server, if set",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Description of the tool server, if set",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ToolServersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = clients.Configs
+}
+
+func (d *ToolServersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ToolServersDataSourceModel
+
+	// Get tool servers from API
+	config, err := d.client.GetToolServers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read tool servers, got error: %s", err))
+		return
+	}
+
+	data.ToolServers = make([]ToolServerModel, 0, len(config.ToolServerConnections))
+	for i, conn := range config.ToolServerConnections {
+		server := ToolServerModel{
+			Index:       types.Int64Value(int64(i)),
+			URL:         types.StringValue(conn.URL),
+			Path:        types.StringValue(conn.Path),
+			AuthType:    types.StringValue(conn.AuthType),
+			Enabled:     types.BoolValue(conn.Enabled()),
+			Name:        types.StringNull(),
+			Description: types.StringNull(),
+		}
+		if conn.Info != nil {
+			server.Name = types.StringValue(conn.Info.Name)
+			server.Description = types.StringValue(conn.Info.Description)
+		}
+		data.ToolServers = append(data.ToolServers, server)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
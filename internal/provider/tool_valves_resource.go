@@ -0,0 +1,203 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/tools"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ToolValvesResource{}
+var _ resource.ResourceWithImportState = &ToolValvesResource{}
+
+func NewToolValvesResource() resource.Resource {
+	return &ToolValvesResource{}
+}
+
+// ToolValvesResource defines the resource implementation.
+type ToolValvesResource struct {
+	client *tools.Client
+}
+
+// ToolValvesResourceModel describes the resource data model.
+type ToolValvesResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	ToolID          types.String `tfsdk:"tool_id"`
+	Valves          types.Map    `tfsdk:"valves"`
+	SensitiveValves types.Map    `tfsdk:"sensitive_valves"`
+}
+
+func (r *ToolValvesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tool_valves"
+}
+
+func (r *ToolValvesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Tool valves resource for OpenWebUI. Sets the valves (settings) of a workspace tool, e.g. the API key of the service it calls. " +
+			"Requires write access to the tool. Valves that are not set keep their current value and are not tracked. Destroying the resource leaves the valves unchanged",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the resource, which is the tool ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tool_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the workspace tool",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"valves": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				MarkdownDescription: "Valve values of the tool. Values are converted to the type of the valve, so numbers, booleans and lists are written as JSON, e.g. `\"60\"` or `jsonencode([\"*\"])`. " +
+					"Use `sensitive_valves` for secrets",
+				Validators: []validator.Map{
+					mapvalidator.AtLeastOneOf(path.MatchRoot("sensitive_valves")),
+				},
+			},
+			"sensitive_valves": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Valve values that are hidden in the plan output, e.g. API keys. Values are converted like `valves`, and a valve cannot be in both maps",
+			},
+		},
+	}
+}
+
+func (r *ToolValvesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Tools
+}
+
+func (r *ToolValvesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ToolValvesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *ToolValvesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ToolValvesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.client.GetValves(ctx, data.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, apierror.ErrNotFound) {
+			// The tool was deleted, so its valves are gone too
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read tool valves, got error: %s", err))
+		return
+	}
+
+	data.ToolID = data.ID
+	resp.Diagnostics.Append(setValvesState(ctx, current, &data.Valves, &data.SensitiveValves)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ToolValvesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ToolValvesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *ToolValvesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The valves are left as they are, the tool keeps using them
+}
+
+func (r *ToolValvesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply sets the configured valves and stores the result in state
+func (r *ToolValvesResource) apply(ctx context.Context, data *ToolValvesResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	id := data.ToolID.ValueString()
+
+	configured := configuredValves(ctx, data.Valves, data.SensitiveValves, diags)
+	if diags.HasError() {
+		return
+	}
+
+	current, err := r.client.GetValves(ctx, id)
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read tool valves, got error: %s", err))
+		return
+	}
+	spec, err := r.client.GetValvesSpec(ctx, id)
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read tool valves, got error: %s", err))
+		return
+	}
+
+	result, err := r.client.UpdateValves(ctx, id, typedValves(configured, current, spec))
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update tool valves, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(id)
+	diags.Append(setValvesState(ctx, result, &data.Valves, &data.SensitiveValves)...)
+	if diags.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags.Append(state.Set(ctx, data)...)
+}
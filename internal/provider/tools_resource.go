@@ -0,0 +1,323 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/tools"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ToolResource{}
+var _ resource.ResourceWithImportState = &ToolResource{}
+
+func NewToolResource() resource.Resource {
+	return &ToolResource{}
+}
+
+// ToolResource defines the resource implementation.
+type ToolResource struct {
+	client *tools.Client
+}
+
+// ToolResourceModel describes the resource data model.
+type ToolResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Content       types.String `tfsdk:"content"`
+	Description   types.String `tfsdk:"description"`
+	Specs         types.String `tfsdk:"specs"`
+	IsPrivate     types.Bool   `tfsdk:"is_private"`
+	AccessControl types.Object `tfsdk:"access_control"`
+	UserID        types.String `tfsdk:"user_id"`
+	CreatedAt     types.Int64  `tfsdk:"created_at"`
+	UpdatedAt     types.Int64  `tfsdk:"updated_at"`
+}
+
+func (r *ToolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tool"
+}
+
+func (r *ToolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Workspace tool resource for OpenWebUI. Tools are Python modules whose functions models can call",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Tool identifier. Must be a valid Python identifier, e.g. `web_scraper`. Changing this forces a new tool to be created",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Display name of the tool",
+			},
+			"content": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Python source code of the tool",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Description of the tool",
+			},
+			"specs": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "JSON encoded function specifications the server derived from `content`",
+			},
+			"is_private": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the tool is private. `access_control` must be unset when this is set to `false`",
+			},
+			"access_control": accessControlResourceAttribute("tool"),
+			"user_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the user who owns the tool",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp when the tool was created",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp when the tool was last updated",
+			},
+		},
+	}
+}
+
+func (r *ToolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Tools
+}
+
+func (r *ToolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ToolResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	form, diags := toolForm(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.Create(ctx, form); err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to create tool, got error: %s", err))
+		return
+	}
+
+	// The create response omits the content and specs, so read the tool back
+	tool, err := r.client.Get(ctx, form.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read tool %s after creation, got error: %s", form.ID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(setToolState(ctx, tool, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ToolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ToolResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tool, err := r.client.Get(ctx, data.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, apierror.ErrNotFound) {
+			// Deleted outside of Terraform, plan to create it again
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read tool, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setToolState(ctx, tool, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ToolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ToolResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	form, diags := toolForm(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tool, err := r.client.Update(ctx, form.ID, form)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update tool, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setToolState(ctx, tool, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ToolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ToolResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Delete(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete tool, got error: %s", err))
+		return
+	}
+}
+
+func (r *ToolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toolForm converts the planned resource data into the API payload.
+func toolForm(ctx context.Context, data *ToolResourceModel) (*tools.ToolForm, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	form := &tools.ToolForm{
+		ID:      data.ID.ValueString(),
+		Name:    data.Name.ValueString(),
+		Content: data.Content.ValueString(),
+	}
+
+	if !data.Description.IsNull() {
+		description := data.Description.ValueString()
+		form.Meta.Description = &description
+	}
+
+	// Public tools have no access control at all
+	if !data.IsPrivate.ValueBool() || data.AccessControl.IsNull() || data.AccessControl.IsUnknown() {
+		return form, diags
+	}
+
+	read, write, d := accessControlFromObject(ctx, data.AccessControl)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	form.AccessControl = &tools.AccessControl{
+		Read:  tools.AccessGroup{GroupIDs: read.GroupIDs, UserIDs: read.UserIDs},
+		Write: tools.AccessGroup{GroupIDs: write.GroupIDs, UserIDs: write.UserIDs},
+	}
+
+	return form, diags
+}
+
+// setToolState copies the server representation of a tool into the resource data.
+func setToolState(ctx context.Context, tool *tools.Tool, data *ToolResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(tool.ID)
+	data.Name = types.StringValue(tool.Name)
+	data.Content = types.StringValue(tool.Content)
+	data.UserID = types.StringValue(tool.UserID)
+	data.CreatedAt = types.Int64Value(tool.CreatedAt)
+	data.UpdatedAt = types.Int64Value(tool.UpdatedAt)
+
+	if tool.Meta.Description != nil && (*tool.Meta.Description != "" || !data.Description.IsNull()) {
+		data.Description = types.StringValue(*tool.Meta.Description)
+	} else {
+		data.Description = types.StringNull()
+	}
+
+	specs := tool.Specs
+	if specs == nil {
+		specs = []map[string]interface{}{}
+	}
+	encoded, err := json.Marshal(specs)
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to encode specs of tool %s, got error: %s", tool.ID, err))
+		return diags
+	}
+	data.Specs = types.StringValue(string(encoded))
+
+	if tool.AccessControl == nil {
+		data.IsPrivate = types.BoolValue(false)
+		data.AccessControl = types.ObjectNull(accessControlAttrTypes)
+		return diags
+	}
+
+	ac := tool.AccessControl
+	data.IsPrivate = types.BoolValue(true)
+	accessControl, d := accessControlObject(ctx,
+		accessGroup{GroupIDs: ac.Read.GroupIDs, UserIDs: ac.Read.UserIDs},
+		accessGroup{GroupIDs: ac.Write.GroupIDs, UserIDs: ac.Write.UserIDs},
+	)
+	diags.Append(d...)
+	data.AccessControl = accessControl
+
+	return diags
+}
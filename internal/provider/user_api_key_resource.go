@@ -0,0 +1,273 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &UserAPIKeyResource{}
+
+func NewUserAPIKeyResource() resource.Resource {
+	return &UserAPIKeyResource{}
+}
+
+// UserAPIKeyResource defines the resource implementation.
+type UserAPIKeyResource struct {
+	client *openwebui.Client
+}
+
+// UserAPIKeyResourceModel describes the resource data model.
+type UserAPIKeyResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Email             types.String `tfsdk:"email"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	RotateWhen        types.Map    `tfsdk:"rotate_when"`
+	Key               types.String `tfsdk:"key"`
+}
+
+func (r *UserAPIKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_api_key"
+}
+
+func (r *UserAPIKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates an API key for a user and revokes it on destroy. OpenWebUI only lets users manage their own key, " +
+			"so the key is generated for the user the provider is authenticated as, or for the user signed in with `email` and `password_wo`. " +
+			"Afterwards the key itself is used to read and revoke it, so the password is only needed to generate a key. " +
+			"A user has at most one API key: generating a key replaces the previous one. " +
+			"Do not manage the key of the provider user while the provider authenticates with that same key",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the user owning the key",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Email of the user to generate the key for. Unset to use the user the provider is authenticated as",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password_wo": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "Password of the user given in `email` as a write-only argument, which is used to sign in but never stored in the plan or state. Requires Terraform 1.11 or later",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("email")),
+				},
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Any change to this value signs in with `password_wo` again and generates a new key, e.g. after the password of the user changed",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"rotate_when": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Arbitrary values that generate a new key whenever they change, e.g. a rotation date from the `time_rotating` resource",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The generated API key",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *UserAPIKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Client
+}
+
+func (r *UserAPIKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserAPIKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.client
+	if !data.Email.IsNull() {
+		// Write-only values are only available in the configuration
+		var password types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &password)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var err error
+		client, err = r.client.Signin(ctx, data.Email.ValueString(), password.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to sign in, got error: %s", err))
+			return
+		}
+	}
+
+	user, err := client.Auths.GetSessionUser(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read user, got error: %s", err))
+		return
+	}
+
+	key, err := client.Auths.RotateAPIKey(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to generate API key for user %s, got error: %s", user.Email, err))
+		return
+	}
+	if key.APIKey == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("No API key was returned for user %s", user.Email))
+		return
+	}
+
+	data.ID = types.StringValue(user.ID)
+	data.Key = types.StringValue(*key.APIKey)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserAPIKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserAPIKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := r.userClient(&data).Auths.GetAPIKey(ctx)
+	if err != nil && !keyRevoked(&data, err) {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read API key, got error: %s", err))
+		return
+	}
+
+	// Revoked or replaced outside of Terraform, plan to generate a new key
+	if err != nil || key.APIKey == nil || *key.APIKey != data.Key.ValueString() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserAPIKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state UserAPIKeyResourceModel
+
+	// Nothing is sent on update, the key stays the same
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = state.ID
+	data.Key = state.Key
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserAPIKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserAPIKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// With create_before_destroy the replacement key has already been
+	// generated, so only revoke the key if it is still the one in state
+	client := r.userClient(&data)
+	key, err := client.Auths.GetAPIKey(ctx)
+	if keyRevoked(&data, err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read API key, got error: %s", err))
+		return
+	}
+	if key.APIKey == nil || *key.APIKey != data.Key.ValueString() {
+		return
+	}
+
+	if err := client.Auths.DeleteAPIKey(ctx); err != nil && !errors.Is(err, apierror.ErrNotFound) {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to revoke API key, got error: %s", err))
+		return
+	}
+}
+
+// userClient returns a client authenticated as the user owning the key. Keys
+// of other users than the provider user are used to authenticate themselves,
+// as their password is not stored.
+func (r *UserAPIKeyResource) userClient(data *UserAPIKeyResourceModel) *openwebui.Client {
+	if data.Email.IsNull() {
+		return r.client
+	}
+	return r.client.WithToken(data.Key.ValueString())
+}
+
+// keyRevoked reports whether err means the key no longer exists. A revoked key
+// of another user fails to authenticate itself.
+func keyRevoked(data *UserAPIKeyResourceModel, err error) bool {
+	if errors.Is(err, apierror.ErrNotFound) {
+		return true
+	}
+	return !data.Email.IsNull() && errors.Is(err, apierror.ErrUnauthorized)
+}
@@ -0,0 +1,238 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/users"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &UserPermissionsResource{}
+var _ resource.ResourceWithImportState = &UserPermissionsResource{}
+
+func NewUserPermissionsResource() resource.Resource {
+	return &UserPermissionsResource{}
+}
+
+// UserPermissionsResource defines the resource implementation.
+type UserPermissionsResource struct {
+	client *users.Client
+}
+
+// UserPermissionsResourceModel describes the resource data model.
+type UserPermissionsResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Workspace types.Object `tfsdk:"workspace"`
+	Chat      types.Object `tfsdk:"chat"`
+	Sharing   types.Object `tfsdk:"sharing"`
+	Features  types.Object `tfsdk:"features"`
+}
+
+// userPermissionSections are the permission sections with the descriptions of
+// their permissions, shared with the group permissions.
+var userPermissionSections = map[string][]map[string]string{
+	"workspace": {workspacePermissionDescriptions},
+	"chat":      {requiredChatPermissionDescriptions, optionalChatPermissionDescriptions},
+	"sharing":   {sharingPermissionDescriptions},
+	"features":  {featurePermissionDescriptions},
+}
+
+func (r *UserPermissionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_permissions"
+}
+
+func (r *UserPermissionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	section := func(description string, descriptions []map[string]string) schema.SingleNestedAttribute {
+		attributes := map[string]schema.Attribute{}
+		for _, d := range descriptions {
+			for name, description := range d {
+				attributes[name] = schema.BoolAttribute{
+					Optional:            true,
+					Computed:            true,
+					MarkdownDescription: strings.TrimSuffix(strings.Replace(description, "members", "users", 1), "."),
+				}
+			}
+		}
+		return schema.SingleNestedAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: description,
+			Attributes:          attributes,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the default permissions of users with the `user` role, such as workspace access, chat deletion and file upload. " +
+			"Permissions granted by groups are added on top of them. " +
+			"Requires an admin token. Settings that are not configured keep their current value. Destroying the resource leaves the settings unchanged",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Always `user`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workspace": section("Workspace permissions", userPermissionSections["workspace"]),
+			"chat":      section("Chat permissions", userPermissionSections["chat"]),
+			"sharing":   section("Sharing permissions", userPermissionSections["sharing"]),
+			"features":  section("Feature permissions", userPermissionSections["features"]),
+		},
+	}
+}
+
+func (r *UserPermissionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Users
+}
+
+func (r *UserPermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserPermissionsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *UserPermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserPermissionsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions, err := r.client.GetDefaultPermissions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read default user permissions, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setUserPermissionsState(permissions, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserPermissionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data UserPermissionsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *UserPermissionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The settings always exist, so there is nothing to delete
+}
+
+func (r *UserPermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply sends the configured permissions and stores the resulting permissions in state.
+func (r *UserPermissionsResource) apply(ctx context.Context, data *UserPermissionsResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	changes := users.Permissions{}
+	for name, obj := range data.sections() {
+		if obj.IsNull() || obj.IsUnknown() {
+			continue
+		}
+		for permission, value := range obj.Attributes() {
+			if v, ok := value.(types.Bool); ok && !v.IsNull() && !v.IsUnknown() {
+				if changes[name] == nil {
+					changes[name] = map[string]bool{}
+				}
+				changes[name][permission] = v.ValueBool()
+			}
+		}
+	}
+
+	permissions, err := r.client.UpdateDefaultPermissions(ctx, changes)
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update default user permissions, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("user")
+	diags.Append(setUserPermissionsState(permissions, data)...)
+	if diags.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags.Append(state.Set(ctx, data)...)
+}
+
+// sections returns the permission sections of the resource data by name.
+func (data *UserPermissionsResourceModel) sections() map[string]*types.Object {
+	return map[string]*types.Object{
+		"workspace": &data.Workspace,
+		"chat":      &data.Chat,
+		"sharing":   &data.Sharing,
+		"features":  &data.Features,
+	}
+}
+
+// setUserPermissionsState copies the server representation of the permissions
+// into the resource data. Permissions the server does not report are null.
+func setUserPermissionsState(permissions users.Permissions, data *UserPermissionsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for name, obj := range data.sections() {
+		attrTypes := map[string]attr.Type{}
+		values := map[string]attr.Value{}
+		for _, descriptions := range userPermissionSections[name] {
+			for permission := range descriptions {
+				attrTypes[permission] = types.BoolType
+				values[permission] = types.BoolNull()
+				if value, ok := permissions[name][permission]; ok {
+					values[permission] = types.BoolValue(value)
+				}
+			}
+		}
+
+		var d diag.Diagnostics
+		*obj, d = types.ObjectValue(attrTypes, values)
+		diags.Append(d...)
+	}
+	return diags
+}
@@ -0,0 +1,244 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/auths"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/users"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &UserSettingsResource{}
+var _ resource.ResourceWithImportState = &UserSettingsResource{}
+
+func NewUserSettingsResource() resource.Resource {
+	return &UserSettingsResource{}
+}
+
+// UserSettingsResource defines the resource implementation.
+type UserSettingsResource struct {
+	client      *users.Client
+	authsClient *auths.Client
+}
+
+// UserSettingsResourceModel describes the resource data model.
+type UserSettingsResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	DefaultModelIDs types.List   `tfsdk:"default_model_ids"`
+	SystemPrompt    types.String `tfsdk:"system_prompt"`
+	UIJSON          types.String `tfsdk:"ui_json"`
+}
+
+// userSettingsFields are the interface settings managed by their own attribute
+var userSettingsFields = map[string]bool{
+	"models": true,
+	"system": true,
+}
+
+func (r *UserSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_settings"
+}
+
+func (r *UserSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the interface settings of a user, such as the default models and the system prompt. " +
+			"Settings belong to the user the provider token was issued for, so use a provider alias with the token of a service account to standardize its settings. " +
+			"Settings that are not configured keep their current value. Destroying the resource leaves the settings unchanged",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the user the settings belong to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_model_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Models selected for new chats",
+			},
+			"system_prompt": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "System prompt added to every chat of the user",
+			},
+			"ui_json": schema.StringAttribute{
+				MarkdownDescription: "JSON object of additional interface settings, e.g. `jsonencode({ chatBubble = false })`. " +
+					"The settings are merged into the current ones and must not include `models` or `system`. Only the settings set here are tracked",
+				Optional: true,
+				Validators: []validator.String{
+					jsonObjectValidator{reserved: userSettingsFields},
+				},
+			},
+		},
+	}
+}
+
+func (r *UserSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Users
+	r.authsClient = clients.Auths
+}
+
+func (r *UserSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.authsClient.GetSessionUser(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read the user of the provider token, got error: %s", err))
+		return
+	}
+	data.ID = types.StringValue(user.ID)
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *UserSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserSettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read user settings, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setUserSettingsState(ctx, settings, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data UserSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *UserSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The settings always exist, so there is nothing to delete
+}
+
+func (r *UserSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply sends the configured settings and stores the resulting settings in state.
+func (r *UserSettingsResource) apply(ctx context.Context, data *UserSettingsResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	changes := map[string]interface{}{}
+	if !data.UIJSON.IsNull() && !data.UIJSON.IsUnknown() {
+		if err := json.Unmarshal([]byte(data.UIJSON.ValueString()), &changes); err != nil {
+			diags.AddAttributeError(path.Root("ui_json"), "Invalid JSON Object", fmt.Sprintf("Expected a JSON object, got error: %s", err))
+			return
+		}
+	}
+	if !data.DefaultModelIDs.IsNull() && !data.DefaultModelIDs.IsUnknown() {
+		modelIDs := []string{}
+		diags.Append(data.DefaultModelIDs.ElementsAs(ctx, &modelIDs, false)...)
+		if diags.HasError() {
+			return
+		}
+		changes["models"] = modelIDs
+	}
+	if systemPrompt := knownString(data.SystemPrompt); systemPrompt != nil {
+		changes["system"] = *systemPrompt
+	}
+
+	settings, err := r.client.UpdateUISettings(ctx, changes)
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update user settings, got error: %s", err))
+		return
+	}
+
+	diags.Append(setUserSettingsState(ctx, settings, data)...)
+	if diags.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags.Append(state.Set(ctx, data)...)
+}
+
+// setUserSettingsState copies the server representation of the settings into
+// the resource data. Only the settings configured in ui_json are tracked.
+func setUserSettingsState(ctx context.Context, settings users.Settings, data *UserSettingsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	ui := settings.UI()
+
+	data.SystemPrompt = types.StringNull()
+	if system, ok := ui["system"].(string); ok {
+		data.SystemPrompt = types.StringValue(system)
+	}
+
+	data.DefaultModelIDs = types.ListNull(types.StringType)
+	if models, ok := ui["models"].([]interface{}); ok {
+		modelIDs := make([]string, 0, len(models))
+		for _, model := range models {
+			if id, ok := model.(string); ok {
+				modelIDs = append(modelIDs, id)
+			}
+		}
+		var d diag.Diagnostics
+		data.DefaultModelIDs, d = types.ListValueFrom(ctx, types.StringType, modelIDs)
+		diags.Append(d...)
+	}
+
+	server := types.StringNull()
+	if ui != nil {
+		if payload, err := json.Marshal(ui); err == nil {
+			server = types.StringValue(string(payload))
+		}
+	}
+	data.UIJSON = managedJSON(server, data.UIJSON)
+
+	return diags
+}
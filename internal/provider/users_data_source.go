@@ -11,7 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	"github.com/coalition-sre/terraform-provider-openwebui/internal/provider/client/users"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/users"
 )
 
 var (
@@ -106,25 +106,16 @@ func (d *UserDataSource) Configure(_ context.Context, req datasource.ConfigureRe
 		return
 	}
 
-	clients, ok := req.ProviderData.(map[string]interface{})
+	clients, ok := req.ProviderData.(*ProviderClients)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	client, ok := clients["users"].(*users.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *users.Client, got: %T. Please report this issue to the provider developers.", clients["users"]),
-		)
-		return
-	}
-
-	d.client = client
+	d.client = clients.Users
 }
 
 func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -168,7 +159,7 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	// Try to find user by ID first
 	if !config.ID.IsNull() {
-		user, err = d.client.GetUser(config.ID.ValueString())
+		user, err = d.client.GetUser(ctx, config.ID.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error reading user by ID",
@@ -178,7 +169,7 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		}
 	} else if !config.Email.IsNull() {
 		// Try to find user by email
-		user, err = d.client.FindUserByEmail(config.Email.ValueString())
+		user, err = d.client.FindUserByEmail(ctx, config.Email.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error reading user by email",
@@ -188,7 +179,7 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		}
 	} else if !config.Name.IsNull() {
 		// Try to find user by name
-		user, err = d.client.FindUserByName(config.Name.ValueString())
+		user, err = d.client.FindUserByName(ctx, config.Name.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error reading user by name",
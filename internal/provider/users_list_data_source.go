@@ -0,0 +1,189 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/users"
+)
+
+var (
+	_ datasource.DataSource = &UsersDataSource{}
+)
+
+type UsersDataSourceModel struct {
+	Role   types.String     `tfsdk:"role"`
+	Search types.String     `tfsdk:"search"`
+	Total  types.Int64      `tfsdk:"total"`
+	Users  []UserEntryModel `tfsdk:"users"`
+}
+
+// UserEntryModel describes a single user in the list.
+type UserEntryModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Email           types.String `tfsdk:"email"`
+	Role            types.String `tfsdk:"role"`
+	ProfileImageURL types.String `tfsdk:"profile_image_url"`
+	LastActiveAt    types.Int64  `tfsdk:"last_active_at"`
+	UpdatedAt       types.Int64  `tfsdk:"updated_at"`
+	CreatedAt       types.Int64  `tfsdk:"created_at"`
+	OAuthSub        types.String `tfsdk:"oauth_sub"`
+}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &UsersDataSource{}
+}
+
+type UsersDataSource struct {
+	client *users.Client
+}
+
+func (d *UsersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *UsersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists users, optionally filtered by role and by a search term matched against name and email.",
+		Attributes: map[string]schema.Attribute{
+			"role": schema.StringAttribute{
+				Description: "Only return users with this role (pending, admin, or user).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("pending", "user", "admin"),
+				},
+			},
+			"search": schema.StringAttribute{
+				Description: "Only return users whose name or email contains this value, ignoring case.",
+				Optional:    true,
+			},
+			"total": schema.Int64Attribute{
+				Description: "The number of users matching `search` as reported by the server, before the role filter is applied.",
+				Computed:    true,
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "The matching users.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the user.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the user.",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "The email address of the user.",
+							Computed:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "The role of the user (pending, admin, or user).",
+							Computed:    true,
+						},
+						"profile_image_url": schema.StringAttribute{
+							Description: "URL of the user's profile image.",
+							Computed:    true,
+						},
+						"last_active_at": schema.Int64Attribute{
+							Description: "Timestamp of the user's last activity.",
+							Computed:    true,
+						},
+						"updated_at": schema.Int64Attribute{
+							Description: "Timestamp when the user was last updated.",
+							Computed:    true,
+						},
+						"created_at": schema.Int64Attribute{
+							Description: "Timestamp when the user was created.",
+							Computed:    true,
+						},
+						"oauth_sub": schema.StringAttribute{
+							Description: "OAuth subject identifier.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UsersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = clients.Users
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config UsersDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	search := config.Search.ValueString()
+	list, err := d.client.ListUsers(ctx, search)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing users",
+			fmt.Sprintf("Could not list users: %s", err.Error()),
+		)
+		return
+	}
+
+	// The server-side search is applied as well, but its matching rules vary
+	// between OpenWebUI versions, so filter again to get consistent results
+	search = strings.ToLower(search)
+
+	config.Total = types.Int64Value(int64(list.Total))
+	config.Users = make([]UserEntryModel, 0, len(list.Users))
+	for _, user := range list.Users {
+		if !config.Role.IsNull() && user.Role.ValueString() != config.Role.ValueString() {
+			continue
+		}
+		if search != "" &&
+			!strings.Contains(strings.ToLower(user.Name.ValueString()), search) &&
+			!strings.Contains(strings.ToLower(user.Email.ValueString()), search) {
+			continue
+		}
+
+		config.Users = append(config.Users, UserEntryModel{
+			ID:              user.ID,
+			Name:            user.Name,
+			Email:           user.Email,
+			Role:            user.Role,
+			ProfileImageURL: user.ProfileImageURL,
+			LastActiveAt:    user.LastActiveAt,
+			UpdatedAt:       user.UpdatedAt,
+			CreatedAt:       user.CreatedAt,
+			OAuthSub:        user.OAuthSub,
+		})
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
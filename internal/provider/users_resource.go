@@ -0,0 +1,313 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/users"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &UserResource{}
+var _ resource.ResourceWithImportState = &UserResource{}
+
+func NewUserResource() resource.Resource {
+	return &UserResource{}
+}
+
+// UserResource defines the resource implementation.
+type UserResource struct {
+	client *users.Client
+}
+
+// UserResourceModel describes the resource data model.
+type UserResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Email             types.String `tfsdk:"email"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	Role              types.String `tfsdk:"role"`
+	ProfileImageURL   types.String `tfsdk:"profile_image_url"`
+	LastActiveAt      types.Int64  `tfsdk:"last_active_at"`
+	CreatedAt         types.Int64  `tfsdk:"created_at"`
+	UpdatedAt         types.Int64  `tfsdk:"updated_at"`
+}
+
+func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an OpenWebUI user account, e.g. for service accounts. Requires an admin token.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the user.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the user.",
+				Required:    true,
+			},
+			"email": schema.StringAttribute{
+				Description: "The email address of the user.",
+				Required:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "The password of the user. It is stored in the Terraform state, use `password_wo` to avoid that. OpenWebUI never returns passwords, so changes made outside of Terraform are not detected.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("password_wo")),
+				},
+			},
+			"password_wo": schema.StringAttribute{
+				Description: "The password of the user as a write-only argument, which is sent to OpenWebUI but never stored in the plan or state. Requires Terraform 1.11 or later. Increment `password_wo_version` to set a new password.",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Description: "Any change to this value sends `password_wo` to OpenWebUI again.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "The role of the user (pending, admin, or user). Set it to `pending` to deactivate the account: the user can no longer sign in or use API keys, but their chats are kept. Set it back to `user` to reactivate the account.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("user"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("pending", "user", "admin"),
+				},
+			},
+			"profile_image_url": schema.StringAttribute{
+				Description: "URL of the user's profile image.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("/user.png"),
+			},
+			"last_active_at": schema.Int64Attribute{
+				Description: "Timestamp when the user was last active, e.g. for access reviews.",
+				Computed:    true,
+			},
+			"created_at": schema.Int64Attribute{
+				Description: "Timestamp when the user was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.Int64Attribute{
+				Description: "Timestamp when the user was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Users
+}
+
+func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan UserResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	password, diags := userPassword(ctx, req.Config, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.AddUser(ctx, &users.APIAddUserForm{
+		Name:            plan.Name.ValueString(),
+		Email:           plan.Email.ValueString(),
+		Password:        password,
+		ProfileImageURL: plan.ProfileImageURL.ValueString(),
+		Role:            plan.Role.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating user",
+			fmt.Sprintf("Could not create user %s: %s", plan.Email.ValueString(), err),
+		)
+		return
+	}
+
+	setUserState(user, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state UserResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.GetUser(ctx, state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, apierror.ErrNotFound) {
+			// Deleted outside of Terraform, plan to create it again
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading user",
+			fmt.Sprintf("Could not read user with ID %s: %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	setUserState(user, &state)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state UserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+
+	form := &users.APIUserUpdateForm{
+		Name:            plan.Name.ValueString(),
+		Email:           plan.Email.ValueString(),
+		ProfileImageURL: plan.ProfileImageURL.ValueString(),
+	}
+	// Only send the password when it changed, so updates don't reset it needlessly.
+	// Write-only passwords are not in the state, so their version is compared instead.
+	if !plan.Password.Equal(state.Password) || !plan.PasswordWOVersion.Equal(state.PasswordWOVersion) {
+		password, diags := userPassword(ctx, req.Config, plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		form.Password = &password
+	}
+
+	user, err := r.client.UpdateUser(ctx, id, form)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating user",
+			fmt.Sprintf("Could not update user with ID %s: %s", id, err),
+		)
+		return
+	}
+
+	if !plan.Role.Equal(state.Role) {
+		user, err = r.client.UpdateUserRole(ctx, id, plan.Role.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating user role",
+				fmt.Sprintf("Could not change role of user with ID %s: %s", id, err),
+			)
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	setUserState(user, &plan)
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state UserResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteUser(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting user",
+			fmt.Sprintf("Could not delete user with ID %s: %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+}
+
+func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// userPassword returns the password to send, taken from password or, since
+// write-only values are only available in the configuration, from password_wo.
+func userPassword(ctx context.Context, config tfsdk.Config, plan UserResourceModel) (string, diag.Diagnostics) {
+	if !plan.Password.IsNull() {
+		return plan.Password.ValueString(), nil
+	}
+
+	var password types.String
+	diags := config.GetAttribute(ctx, path.Root("password_wo"), &password)
+	return password.ValueString(), diags
+}
+
+// setUserState copies the server representation of a user into the resource data.
+// The password is never returned by the API and is left untouched.
+func setUserState(user *users.User, data *UserResourceModel) {
+	data.ID = user.ID
+	data.Name = user.Name
+	data.Email = user.Email
+	data.Role = user.Role
+	data.ProfileImageURL = user.ProfileImageURL
+	data.LastActiveAt = user.LastActiveAt
+	data.CreatedAt = user.CreatedAt
+	data.UpdatedAt = user.UpdatedAt
+}
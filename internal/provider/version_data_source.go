@@ -0,0 +1,121 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/system"
+)
+
+var (
+	_ datasource.DataSource = &VersionDataSource{}
+)
+
+type VersionDataSourceModel struct {
+	Version    types.String `tfsdk:"version"`
+	Major      types.Int64  `tfsdk:"major"`
+	Minor      types.Int64  `tfsdk:"minor"`
+	Patch      types.Int64  `tfsdk:"patch"`
+	Prerelease types.String `tfsdk:"prerelease"`
+	Build      types.String `tfsdk:"build"`
+}
+
+func NewVersionDataSource() datasource.DataSource {
+	return &VersionDataSource{}
+}
+
+type VersionDataSource struct {
+	client *system.Client
+}
+
+func (d *VersionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_version"
+}
+
+func (d *VersionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Exposes the version of the connected OpenWebUI server, e.g. to guard features of newer releases with preconditions.",
+		Attributes: map[string]schema.Attribute{
+			"version": schema.StringAttribute{
+				Description: "The version reported by the server, e.g. `0.6.5`.",
+				Computed:    true,
+			},
+			"major": schema.Int64Attribute{
+				Description: "The major component of the version. Null when the version cannot be parsed, e.g. for development builds.",
+				Computed:    true,
+			},
+			"minor": schema.Int64Attribute{
+				Description: "The minor component of the version. Null when the version cannot be parsed.",
+				Computed:    true,
+			},
+			"patch": schema.Int64Attribute{
+				Description: "The patch component of the version. Null when the version cannot be parsed.",
+				Computed:    true,
+			},
+			"prerelease": schema.StringAttribute{
+				Description: "The pre-release of the version, e.g. `dev` for `0.6.15-dev`. Null for releases.",
+				Computed:    true,
+			},
+			"build": schema.StringAttribute{
+				Description: "The build metadata of the version, e.g. the commit `a1b2c3d` for `0.6.15+a1b2c3d`. Null when the reported version carries none.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *VersionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = clients.System
+}
+
+func (d *VersionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	version, err := d.client.GetVersion(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to get server version, got error: %s", err))
+		return
+	}
+
+	state := VersionDataSourceModel{
+		Version:    types.StringValue(version.Version),
+		Major:      types.Int64Null(),
+		Minor:      types.Int64Null(),
+		Patch:      types.Int64Null(),
+		Prerelease: types.StringNull(),
+		Build:      types.StringNull(),
+	}
+	if major, minor, patch, ok := version.Parts(); ok {
+		state.Major = types.Int64Value(int64(major))
+		state.Minor = types.Int64Value(int64(minor))
+		state.Patch = types.Int64Value(int64(patch))
+	}
+	prerelease, build := version.Build()
+	if prerelease != "" {
+		state.Prerelease = types.StringValue(prerelease)
+	}
+	if build != "" {
+		state.Build = types.StringValue(build)
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,52 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/system"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// versionGate is an attribute that is only understood by OpenWebUI servers of
+// at least the given version. Older servers silently drop it.
+type versionGate struct {
+	path    path.Path
+	minimum string
+}
+
+// checkVersionGates reports every gated attribute set in config that the
+// connected server does not support. Nothing is checked when the server
+// version is not known, e.g. while validating without a configured provider.
+func checkVersionGates(ctx context.Context, version *system.Version, config tfsdk.Config, gates []versionGate) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if version == nil {
+		return diags
+	}
+
+	for _, gate := range gates {
+		if version.AtLeast(gate.minimum) {
+			continue
+		}
+
+		var value attr.Value
+		diags.Append(config.GetAttribute(ctx, gate.path, &value)...)
+		if value == nil || value.IsNull() {
+			continue
+		}
+
+		diags.AddAttributeError(
+			gate.path,
+			"Attribute Not Supported by OpenWebUI Version",
+			fmt.Sprintf("%s requires OpenWebUI %s or newer, but the server runs %s. Upgrade OpenWebUI or remove the attribute.", gate.path, gate.minimum, version.Version),
+		)
+	}
+
+	return diags
+}
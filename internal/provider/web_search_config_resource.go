@@ -0,0 +1,257 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/configs"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &WebSearchConfigResource{}
+var _ resource.ResourceWithImportState = &WebSearchConfigResource{}
+
+func NewWebSearchConfigResource() resource.Resource {
+	return &WebSearchConfigResource{}
+}
+
+// WebSearchConfigResource defines the resource implementation.
+type WebSearchConfigResource struct {
+	client *configs.Client
+}
+
+// WebSearchConfigResourceModel describes the resource data model.
+type WebSearchConfigResourceModel struct {
+	ID                          types.String `tfsdk:"id"`
+	Enabled                     types.Bool   `tfsdk:"enabled"`
+	Engine                      types.String `tfsdk:"engine"`
+	APIKey                      types.String `tfsdk:"api_key"`
+	ResultCount                 types.Int64  `tfsdk:"result_count"`
+	ConcurrentRequests          types.Int64  `tfsdk:"concurrent_requests"`
+	DomainFilterList            types.List   `tfsdk:"domain_filter_list"`
+	TrustEnv                    types.Bool   `tfsdk:"trust_env"`
+	BypassEmbeddingAndRetrieval types.Bool   `tfsdk:"bypass_embedding_and_retrieval"`
+	SearxngQueryURL             types.String `tfsdk:"searxng_query_url"`
+	GooglePSEEngineID           types.String `tfsdk:"google_pse_engine_id"`
+}
+
+func (r *WebSearchConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_web_search_config"
+}
+
+func (r *WebSearchConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages web search in chats. Requires an admin token. " +
+			"Settings that are not configured keep their current value. Destroying the resource leaves the settings unchanged",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Always `web_search`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether users can search the web from chats",
+			},
+			"engine": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Search engine, e.g. `searxng`, `google_pse`, `brave`, `tavily`, `duckduckgo` or `bing`",
+			},
+			"api_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "API key of the search engine. OpenWebUI keeps a separate key per engine, this sets the one of `engine`",
+			},
+			"result_count": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Number of search results added to the context",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"concurrent_requests": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Number of search result pages loaded in parallel",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"domain_filter_list": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Domains search results are limited to. Prefix a domain with `!` to exclude it instead",
+			},
+			"trust_env": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether search result pages are loaded through the proxy configured in the environment of OpenWebUI",
+			},
+			"bypass_embedding_and_retrieval": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether search results are added to the context as is, instead of being embedded and retrieved like documents",
+			},
+			"searxng_query_url": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Query URL of the SearXNG instance used by the `searxng` engine, e.g. `http://searxng:8080/search?q=<query>`",
+			},
+			"google_pse_engine_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Programmable Search Engine ID used by the `google_pse` engine",
+			},
+		},
+	}
+}
+
+func (r *WebSearchConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*ProviderClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clients.Configs
+}
+
+func (r *WebSearchConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WebSearchConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *WebSearchConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WebSearchConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.GetWebSearchConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read web search config, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setWebSearchConfigState(ctx, config, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WebSearchConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data WebSearchConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.State, &resp.Diagnostics)
+}
+
+func (r *WebSearchConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The settings always exist, so there is nothing to delete
+}
+
+func (r *WebSearchConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply sends the configured settings and stores the resulting configuration in state.
+func (r *WebSearchConfigResource) apply(ctx context.Context, data *WebSearchConfigResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	domains, d := knownStringList(ctx, data.DomainFilterList)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	config, err := r.client.UpdateWebSearchConfig(ctx, &configs.WebSearchConfig{
+		Enabled:                     knownBool(data.Enabled),
+		Engine:                      knownString(data.Engine),
+		ResultCount:                 knownInt64(data.ResultCount),
+		ConcurrentRequests:          knownInt64(data.ConcurrentRequests),
+		DomainFilterList:            domains,
+		TrustEnv:                    knownBool(data.TrustEnv),
+		BypassEmbeddingAndRetrieval: knownBool(data.BypassEmbeddingAndRetrieval),
+		SearxngQueryURL:             knownString(data.SearxngQueryURL),
+		GooglePSEEngineID:           knownString(data.GooglePSEEngineID),
+		APIKey:                      knownString(data.APIKey),
+	})
+	if err != nil {
+		diags.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update web search config, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("web_search")
+	diags.Append(setWebSearchConfigState(ctx, config, data)...)
+	if diags.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags.Append(state.Set(ctx, data)...)
+}
+
+// setWebSearchConfigState copies the server representation of the settings
+// into the resource data. The API key is only read when it is configured.
+func setWebSearchConfigState(ctx context.Context, config *configs.WebSearchConfig, data *WebSearchConfigResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Enabled = types.BoolPointerValue(config.Enabled)
+	data.Engine = types.StringPointerValue(config.Engine)
+	if !data.APIKey.IsNull() {
+		data.APIKey = types.StringPointerValue(config.APIKey)
+	}
+	data.ResultCount = types.Int64PointerValue(config.ResultCount)
+	data.ConcurrentRequests = types.Int64PointerValue(config.ConcurrentRequests)
+	data.DomainFilterList, diags = stringListPointerValue(ctx, config.DomainFilterList)
+	data.TrustEnv = types.BoolPointerValue(config.TrustEnv)
+	data.BypassEmbeddingAndRetrieval = types.BoolPointerValue(config.BypassEmbeddingAndRetrieval)
+	data.SearxngQueryURL = types.StringPointerValue(config.SearxngQueryURL)
+	data.GooglePSEEngineID = types.StringPointerValue(config.GooglePSEEngineID)
+
+	return diags
+}
@@ -0,0 +1,144 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+// Package apierror holds the errors shared by the OpenWebUI client packages.
+package apierror
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ErrNotFound is returned by the client packages when the requested object does not exist
+var ErrNotFound = errors.New("not found")
+
+// ErrUnauthorized, ErrForbidden and ErrConflict classify failed responses, see APIError.Is
+var (
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrConflict     = errors.New("conflict")
+)
+
+// maxMessageLength caps the size of a message taken from a response body that is not JSON
+const maxMessageLength = 512
+
+// notFoundDetail is the message OpenWebUI returns when an object does not exist.
+// Depending on the endpoint it is sent with a 400, 401 or 404 status code.
+const notFoundDetail = "We could not find what you're looking for :/"
+
+// IsNotFound reports whether a failed response means that the object does not exist
+func IsNotFound(statusCode int, body []byte) bool {
+	if statusCode == http.StatusNotFound {
+		return true
+	}
+
+	var response struct {
+		Detail string `json:"detail"`
+	}
+	return json.Unmarshal(body, &response) == nil && response.Detail == notFoundDetail
+}
+
+// APIError is returned by the client packages when the OpenWebUI API answers
+// with an unexpected status code. Use errors.Is with ErrNotFound,
+// ErrUnauthorized, ErrForbidden or ErrConflict to branch on the error class,
+// or errors.As to inspect the details.
+type APIError struct {
+	StatusCode int
+	Method     string
+	Endpoint   string
+	// Message is the detail sent by the server, if any
+	Message string
+
+	notFound bool
+}
+
+// New builds the error for a response whose body has already been read
+func New(resp *http.Response, body []byte) *APIError {
+	e := &APIError{
+		StatusCode: resp.StatusCode,
+		Message:    message(body),
+		notFound:   IsNotFound(resp.StatusCode, body),
+	}
+	if resp.Request != nil {
+		e.Method = resp.Request.Method
+		e.Endpoint = resp.Request.URL.Path
+	}
+	return e
+}
+
+// FromResponse builds the error for a response, reading its body
+func FromResponse(resp *http.Response) *APIError {
+	body, _ := io.ReadAll(resp.Body)
+	return New(resp, body)
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("API returned status code %d", e.StatusCode)
+	if e.Endpoint != "" {
+		msg += fmt.Sprintf(" for %s %s", e.Method, e.Endpoint)
+	}
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	return msg
+}
+
+// Is reports whether the error belongs to the class of target. OpenWebUI answers
+// some lookups of missing objects with a 401, so those only match ErrNotFound.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.notFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized && !e.notFound
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	}
+	return false
+}
+
+// message extracts the detail of an error response. FastAPI sends either a
+// string or, for validation errors, a list of objects with a msg field.
+func message(body []byte) string {
+	var response struct {
+		Detail json.RawMessage `json:"detail"`
+	}
+	if err := json.Unmarshal(body, &response); err == nil && len(response.Detail) > 0 {
+		var detail string
+		if json.Unmarshal(response.Detail, &detail) == nil {
+			return detail
+		}
+
+		var validation []struct {
+			Loc []interface{} `json:"loc"`
+			Msg string        `json:"msg"`
+		}
+		if json.Unmarshal(response.Detail, &validation) == nil {
+			msgs := make([]string, 0, len(validation))
+			for _, v := range validation {
+				loc := make([]string, 0, len(v.Loc))
+				for _, l := range v.Loc {
+					loc = append(loc, fmt.Sprint(l))
+				}
+				if len(loc) > 0 {
+					msgs = append(msgs, fmt.Sprintf("%s: %s", strings.Join(loc, "."), v.Msg))
+				} else {
+					msgs = append(msgs, v.Msg)
+				}
+			}
+			return strings.Join(msgs, "; ")
+		}
+	}
+
+	msg := strings.TrimSpace(string(body))
+	if len(msg) > maxMessageLength {
+		msg = msg[:maxMessageLength] + "..."
+	}
+	return msg
+}
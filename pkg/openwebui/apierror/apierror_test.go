@@ -0,0 +1,105 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package apierror
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestIsNotFound(t *testing.T) {
+	tests := map[string]struct {
+		statusCode int
+		body       string
+		want       bool
+	}{
+		"404":                 {http.StatusNotFound, ``, true},
+		"401 with not found":  {http.StatusUnauthorized, `{"detail":"We could not find what you're looking for :/"}`, true},
+		"400 with not found":  {http.StatusBadRequest, `{"detail":"We could not find what you're looking for :/"}`, true},
+		"401 unauthenticated": {http.StatusUnauthorized, `{"detail":"Not authenticated"}`, false},
+		"500 without json":    {http.StatusInternalServerError, `Internal Server Error`, false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsNotFound(test.statusCode, []byte(test.body)); got != test.want {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestAPIError(t *testing.T) {
+	request := &http.Request{Method: "POST", URL: &url.URL{Path: "/api/v1/models/create"}}
+	tests := map[string]struct {
+		statusCode int
+		body       string
+		message    string
+		is         []error
+		isNot      []error
+	}{
+		"string detail": {
+			statusCode: http.StatusConflict,
+			body:       `{"detail":"Model already exists"}`,
+			message:    "API returned status code 409 for POST /api/v1/models/create: Model already exists",
+			is:         []error{ErrConflict},
+			isNot:      []error{ErrNotFound, ErrUnauthorized, ErrForbidden},
+		},
+		"validation detail": {
+			statusCode: http.StatusUnprocessableEntity,
+			body:       `{"detail":[{"loc":["body","name"],"msg":"Field required","type":"missing"}]}`,
+			message:    "API returned status code 422 for POST /api/v1/models/create: body.name: Field required",
+		},
+		"plain body": {
+			statusCode: http.StatusBadGateway,
+			body:       "Bad Gateway\n",
+			message:    "API returned status code 502 for POST /api/v1/models/create: Bad Gateway",
+		},
+		"not found as 401": {
+			statusCode: http.StatusUnauthorized,
+			body:       `{"detail":"We could not find what you're looking for :/"}`,
+			message:    "API returned status code 401 for POST /api/v1/models/create: We could not find what you're looking for :/",
+			is:         []error{ErrNotFound},
+			isNot:      []error{ErrUnauthorized},
+		},
+		"unauthorized": {
+			statusCode: http.StatusUnauthorized,
+			body:       `{"detail":"Not authenticated"}`,
+			message:    "API returned status code 401 for POST /api/v1/models/create: Not authenticated",
+			is:         []error{ErrUnauthorized},
+			isNot:      []error{ErrNotFound},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: test.statusCode, Request: request}
+			err := fmt.Errorf("model m: %w", New(resp, []byte(test.body)))
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("errors.As did not find an APIError in %v", err)
+			}
+			if got := apiErr.Error(); got != test.message {
+				t.Errorf("Error() = %q, want %q", got, test.message)
+			}
+			if apiErr.StatusCode != test.statusCode {
+				t.Errorf("StatusCode = %d, want %d", apiErr.StatusCode, test.statusCode)
+			}
+			for _, target := range test.is {
+				if !errors.Is(err, target) {
+					t.Errorf("errors.Is(%v) = false, want true", target)
+				}
+			}
+			for _, target := range test.isNot {
+				if errors.Is(err, target) {
+					t.Errorf("errors.Is(%v) = true, want false", target)
+				}
+			}
+		})
+	}
+}
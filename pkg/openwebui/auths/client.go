@@ -0,0 +1,73 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package auths
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/internal/rest"
+)
+
+// Client implements the authentication operations of the authenticated user
+type Client struct {
+	api *rest.Client
+	// anonymous sends requests without the token, e.g. to sign in
+	anonymous *rest.Client
+}
+
+// NewClient creates a new auths client
+func NewClient(endpoint, token string, httpClient *http.Client) *Client {
+	return &Client{
+		api:       rest.New(endpoint, token, httpClient),
+		anonymous: rest.New(endpoint, "", httpClient),
+	}
+}
+
+// Signin exchanges an email and password for a session token. It does not use the token of the client.
+func (c *Client) Signin(ctx context.Context, email, password string) (*SessionUser, error) {
+	var result SessionUser
+	if err := c.anonymous.Do(ctx, "POST", "/api/v1/auths/signin", SigninForm{Email: email, Password: password}, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Token == "" {
+		return nil, fmt.Errorf("API returned no session token")
+	}
+
+	return &result, nil
+}
+
+// GetSessionUser gets the user the token of the client belongs to
+func (c *Client) GetSessionUser(ctx context.Context) (*SessionUser, error) {
+	var result SessionUser
+	if err := c.api.Do(ctx, "GET", "/api/v1/auths/", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetAPIKey gets the current API key of the authenticated user
+func (c *Client) GetAPIKey(ctx context.Context) (*APIKey, error) {
+	return c.doAPIKey(ctx, "GET")
+}
+
+// RotateAPIKey generates a new API key for the authenticated user, replacing the previous one
+func (c *Client) RotateAPIKey(ctx context.Context) (*APIKey, error) {
+	return c.doAPIKey(ctx, "POST")
+}
+
+// DeleteAPIKey revokes the API key of the authenticated user
+func (c *Client) DeleteAPIKey(ctx context.Context) error {
+	return c.api.Do(ctx, "DELETE", "/api/v1/auths/api_key", nil, nil)
+}
+
+func (c *Client) doAPIKey(ctx context.Context, method string) (*APIKey, error) {
+	var result APIKey
+	if err := c.api.Do(ctx, method, "/api/v1/auths/api_key", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,88 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package auths
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+)
+
+func TestSigninDoesNotSendToken(t *testing.T) {
+	var form SigninForm
+	var authorization string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v1/auths/signin" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		authorization = r.Header.Get("Authorization")
+		_ = json.NewDecoder(r.Body).Decode(&form)
+		_, _ = w.Write([]byte(`{"id":"u1","email":"admin@example.com","role":"admin","token":"session","token_type":"Bearer"}`))
+	}))
+	defer ts.Close()
+
+	user, err := NewClient(ts.URL, "token", ts.Client()).Signin(context.Background(), "admin@example.com", "secret")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if form.Email != "admin@example.com" || form.Password != "secret" {
+		t.Errorf("sent form %+v", form)
+	}
+	if authorization != "" {
+		t.Errorf("Authorization = %q, want none", authorization)
+	}
+	if user.ID != "u1" || user.Token != "session" {
+		t.Errorf("user = %+v", user)
+	}
+}
+
+func TestSigninWithoutToken(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"u1"}`))
+	}))
+	defer ts.Close()
+
+	if _, err := NewClient(ts.URL, "", ts.Client()).Signin(context.Background(), "admin@example.com", "secret"); err == nil {
+		t.Error("expected an error for a response without session token")
+	}
+}
+
+func TestRotateAPIKey(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v1/auths/api_key" || r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"api_key":"sk-new"}`))
+	}))
+	defer ts.Close()
+
+	key, err := NewClient(ts.URL, "token", ts.Client()).RotateAPIKey(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if key.APIKey == nil || *key.APIKey != "sk-new" {
+		t.Errorf("api_key = %v, want sk-new", key.APIKey)
+	}
+}
+
+func TestGetSessionUserUnauthorized(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"detail":"Not authenticated"}`))
+	}))
+	defer ts.Close()
+
+	_, err := NewClient(ts.URL, "revoked", ts.Client()).GetSessionUser(context.Background())
+	if !errors.Is(err, apierror.ErrUnauthorized) {
+		t.Errorf("error = %v, want ErrUnauthorized", err)
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package auths
+
+// APIKey represents the API key of the authenticated user
+type APIKey struct {
+	APIKey *string `json:"api_key"`
+}
+
+// SigninForm holds the credentials used to sign in
+type SigninForm struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// SessionUser represents a signed in user. Token is only set by Signin.
+type SessionUser struct {
+	ID        string `json:"id"`
+	Email     string `json:"email"`
+	Name      string `json:"name"`
+	Role      string `json:"role"`
+	Token     string `json:"token"`
+	TokenType string `json:"token_type"`
+	ExpiresAt *int64 `json:"expires_at"`
+}
@@ -0,0 +1,63 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package channels
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/internal/rest"
+)
+
+// Client implements the channel operations
+type Client struct {
+	api *rest.Client
+}
+
+// NewClient creates a new channels client
+func NewClient(endpoint, token string, httpClient *http.Client) *Client {
+	return &Client{api: rest.New(endpoint, token, httpClient)}
+}
+
+// List gets all channels visible to the authenticated user
+func (c *Client) List(ctx context.Context) ([]Channel, error) {
+	var result []Channel
+	if err := c.api.Do(ctx, "GET", "/api/v1/channels/", nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Create creates a new channel
+func (c *Client) Create(ctx context.Context, form *ChannelForm) (*Channel, error) {
+	var result Channel
+	if err := c.api.Do(ctx, "POST", "/api/v1/channels/create", form, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Get gets a channel by ID
+func (c *Client) Get(ctx context.Context, id string) (*Channel, error) {
+	var result Channel
+	if err := c.api.Do(ctx, "GET", fmt.Sprintf("/api/v1/channels/%s", id), nil, &result); err != nil {
+		return nil, fmt.Errorf("channel %s: %w", id, err)
+	}
+	return &result, nil
+}
+
+// Update updates an existing channel
+func (c *Client) Update(ctx context.Context, id string, form *ChannelForm) (*Channel, error) {
+	var result Channel
+	if err := c.api.Do(ctx, "POST", fmt.Sprintf("/api/v1/channels/%s/update", id), form, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Delete deletes a channel
+func (c *Client) Delete(ctx context.Context, id string) error {
+	return c.api.Do(ctx, "DELETE", fmt.Sprintf("/api/v1/channels/%s/delete", id), nil, nil)
+}
@@ -0,0 +1,53 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package channels
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+)
+
+func TestCreateSendsNullAccessControl(t *testing.T) {
+	var sent map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v1/channels/create" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&sent)
+		_, _ = w.Write([]byte(`{"id":"c1","user_id":"u1","name":"general","access_control":null}`))
+	}))
+	defer ts.Close()
+
+	channel, err := NewClient(ts.URL, "token", ts.Client()).Create(context.Background(), &ChannelForm{Name: "general"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if ac, ok := sent["access_control"]; !ok || ac != nil {
+		t.Errorf("access_control = %v, want null to make the channel public", sent["access_control"])
+	}
+	if channel.ID != "c1" || channel.Name != "general" {
+		t.Errorf("channel = %+v", channel)
+	}
+}
+
+func TestGetNotFound(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"detail":"We could not find what you're looking for :/"}`))
+	}))
+	defer ts.Close()
+
+	_, err := NewClient(ts.URL, "token", ts.Client()).Get(context.Background(), "missing")
+	if !errors.Is(err, apierror.ErrNotFound) {
+		t.Errorf("error = %v, want ErrNotFound", err)
+	}
+}
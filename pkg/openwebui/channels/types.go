@@ -0,0 +1,35 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package channels
+
+// Channel represents a channel
+type Channel struct {
+	ID            string         `json:"id"`
+	UserID        string         `json:"user_id"`
+	Name          string         `json:"name"`
+	Description   *string        `json:"description"`
+	AccessControl *AccessControl `json:"access_control"`
+	UpdatedAt     int64          `json:"updated_at"`
+	CreatedAt     int64          `json:"created_at"`
+}
+
+// ChannelForm represents the payload for creating or updating a channel
+type ChannelForm struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	// AccessControl is sent as null to make the channel public
+	AccessControl *AccessControl `json:"access_control"`
+}
+
+// AccessControl represents the users and groups allowed to access a private channel
+type AccessControl struct {
+	Read  AccessGroup `json:"read"`
+	Write AccessGroup `json:"write"`
+}
+
+// AccessGroup represents the users and groups granted a single permission
+type AccessGroup struct {
+	GroupIDs []string `json:"group_ids"`
+	UserIDs  []string `json:"user_ids"`
+}
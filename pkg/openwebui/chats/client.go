@@ -0,0 +1,49 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package chats
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/internal/rest"
+)
+
+// Client implements the chat operations
+type Client struct {
+	api *rest.Client
+}
+
+// NewClient creates a new chats client
+func NewClient(endpoint, token string, httpClient *http.Client) *Client {
+	return &Client{api: rest.New(endpoint, token, httpClient)}
+}
+
+// ListAll gets the chats of all users, including archived chats. Requires an
+// admin token and ENABLE_ADMIN_EXPORT, which is enabled by default. The
+// messages of the chats are not decoded.
+func (c *Client) ListAll(ctx context.Context) ([]Chat, error) {
+	var result []Chat
+	if err := c.api.Do(ctx, "GET", "/api/v1/chats/all/db", nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListByUser gets the chats of a user, including archived chats. Requires an
+// admin token.
+func (c *Client) ListByUser(ctx context.Context, userID string) ([]Chat, error) {
+	all, err := c.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []Chat{}
+	for _, chat := range all {
+		if chat.UserID == userID {
+			result = append(result, chat)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,47 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package chats
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+)
+
+func TestListByUser(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/chats/all/db" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`[{"id":"c1","user_id":"u1","title":"A"},{"id":"c2","user_id":"u2","title":"B"},{"id":"c3","user_id":"u1","title":"C","archived":true}]`))
+	}))
+	defer ts.Close()
+
+	chats, err := NewClient(ts.URL, "token", ts.Client()).ListByUser(context.Background(), "u1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(chats) != 2 || chats[0].ID != "c1" || chats[1].ID != "c3" || !chats[1].Archived {
+		t.Errorf("chats = %+v, want c1 and archived c3", chats)
+	}
+}
+
+func TestListAllForbidden(t *testing.T) {
+	// Servers with ENABLE_ADMIN_EXPORT disabled refuse the export
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"detail":"You do not have permission to access this resource."}`))
+	}))
+	defer ts.Close()
+
+	_, err := NewClient(ts.URL, "token", ts.Client()).ListAll(context.Background())
+	if !errors.Is(err, apierror.ErrForbidden) {
+		t.Errorf("error = %v, want ErrForbidden", err)
+	}
+}
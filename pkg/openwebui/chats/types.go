@@ -0,0 +1,17 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package chats
+
+// Chat represents a chat without its messages
+type Chat struct {
+	ID        string  `json:"id"`
+	UserID    string  `json:"user_id"`
+	Title     string  `json:"title"`
+	Archived  bool    `json:"archived"`
+	Pinned    *bool   `json:"pinned,omitempty"`
+	FolderID  *string `json:"folder_id,omitempty"`
+	ShareID   *string `json:"share_id,omitempty"`
+	CreatedAt int64   `json:"created_at"`
+	UpdatedAt int64   `json:"updated_at"`
+}
@@ -0,0 +1,64 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package configs
+
+import "context"
+
+// AudioConfig represents the speech-to-text and text-to-speech settings. Nil
+// fields are left unchanged by UpdateAudioConfig.
+type AudioConfig struct {
+	TTS *TTSConfig `json:"tts,omitempty"`
+	STT *STTConfig `json:"stt,omitempty"`
+}
+
+// TTSConfig represents the text-to-speech settings
+type TTSConfig struct {
+	Engine        *string `json:"ENGINE,omitempty"`
+	Model         *string `json:"MODEL,omitempty"`
+	Voice         *string `json:"VOICE,omitempty"`
+	SplitOn       *string `json:"SPLIT_ON,omitempty"`
+	OpenAIBaseURL *string `json:"OPENAI_API_BASE_URL,omitempty"`
+	OpenAIAPIKey  *string `json:"OPENAI_API_KEY,omitempty"`
+	APIKey        *string `json:"API_KEY,omitempty"`
+}
+
+// STTConfig represents the speech-to-text settings
+type STTConfig struct {
+	Engine         *string `json:"ENGINE,omitempty"`
+	Model          *string `json:"MODEL,omitempty"`
+	WhisperModel   *string `json:"WHISPER_MODEL,omitempty"`
+	OpenAIBaseURL  *string `json:"OPENAI_API_BASE_URL,omitempty"`
+	OpenAIAPIKey   *string `json:"OPENAI_API_KEY,omitempty"`
+	DeepgramAPIKey *string `json:"DEEPGRAM_API_KEY,omitempty"`
+}
+
+// GetAudioConfig gets the speech-to-text and text-to-speech settings
+func (c *Client) GetAudioConfig(ctx context.Context) (*AudioConfig, error) {
+	var result AudioConfig
+	if err := c.do(ctx, "GET", "/api/v1/audio/config", nil, &result); err != nil {
+		return nil, err
+	}
+	result.normalize()
+	return &result, nil
+}
+
+// UpdateAudioConfig changes the speech-to-text and text-to-speech settings
+func (c *Client) UpdateAudioConfig(ctx context.Context, form *AudioConfig) (*AudioConfig, error) {
+	var result AudioConfig
+	if err := c.update(ctx, "/api/v1/audio/config", "/api/v1/audio/config/update", form, &result); err != nil {
+		return nil, err
+	}
+	result.normalize()
+	return &result, nil
+}
+
+// normalize makes sure both sections are set, so callers do not have to check for nil.
+func (a *AudioConfig) normalize() {
+	if a.TTS == nil {
+		a.TTS = &TTSConfig{}
+	}
+	if a.STT == nil {
+		a.STT = &STTConfig{}
+	}
+}
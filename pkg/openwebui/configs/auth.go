@@ -0,0 +1,37 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package configs
+
+import "context"
+
+// AuthConfig represents the signup and authentication settings. Nil fields
+// are left unchanged by UpdateAuthConfig.
+type AuthConfig struct {
+	ShowAdminDetails *bool   `json:"SHOW_ADMIN_DETAILS,omitempty"`
+	EnableSignup     *bool   `json:"ENABLE_SIGNUP,omitempty"`
+	EnableAPIKey     *bool   `json:"ENABLE_API_KEY,omitempty"`
+	DefaultUserRole  *string `json:"DEFAULT_USER_ROLE,omitempty"`
+	JWTExpiresIn     *string `json:"JWT_EXPIRES_IN,omitempty"`
+
+	EnableCommunitySharing *bool `json:"ENABLE_COMMUNITY_SHARING,omitempty"`
+	EnableMessageRating    *bool `json:"ENABLE_MESSAGE_RATING,omitempty"`
+}
+
+// GetAuthConfig gets the signup and authentication settings
+func (c *Client) GetAuthConfig(ctx context.Context) (*AuthConfig, error) {
+	var result AuthConfig
+	if err := c.do(ctx, "GET", "/api/v1/auths/admin/config", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateAuthConfig changes the signup and authentication settings
+func (c *Client) UpdateAuthConfig(ctx context.Context, form *AuthConfig) (*AuthConfig, error) {
+	var result AuthConfig
+	if err := c.update(ctx, "/api/v1/auths/admin/config", "/api/v1/auths/admin/config", form, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
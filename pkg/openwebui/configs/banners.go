@@ -0,0 +1,101 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package configs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+)
+
+// Banner represents an announcement shown above the chat
+type Banner struct {
+	ID          string  `json:"id"`
+	Type        string  `json:"type"`
+	Title       *string `json:"title,omitempty"`
+	Content     string  `json:"content"`
+	Dismissible bool    `json:"dismissible"`
+	Timestamp   int64   `json:"timestamp"`
+}
+
+// GetBanners gets all banners
+func (c *Client) GetBanners(ctx context.Context) ([]Banner, error) {
+	result := []Banner{}
+	if err := c.do(ctx, "GET", "/api/v1/configs/banners", nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetBanner gets a banner by ID
+func (c *Client) GetBanner(ctx context.Context, id string) (*Banner, error) {
+	banners, err := c.GetBanners(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, banner := range banners {
+		if banner.ID == id {
+			return &banner, nil
+		}
+	}
+	return nil, fmt.Errorf("banner %s: %w", id, apierror.ErrNotFound)
+}
+
+// SetBanner adds the banner, or replaces the banner with the same ID
+func (c *Client) SetBanner(ctx context.Context, banner *Banner) (*Banner, error) {
+	c.bannersMu.Lock()
+	defer c.bannersMu.Unlock()
+
+	banners, err := c.GetBanners(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	replaced := false
+	for i := range banners {
+		if banners[i].ID == banner.ID {
+			banners[i] = *banner
+			replaced = true
+		}
+	}
+	if !replaced {
+		banners = append(banners, *banner)
+	}
+
+	if err := c.setBanners(ctx, banners); err != nil {
+		return nil, err
+	}
+	return c.GetBanner(ctx, banner.ID)
+}
+
+// DeleteBanner removes the banner with the given ID. Missing banners are ignored.
+func (c *Client) DeleteBanner(ctx context.Context, id string) error {
+	c.bannersMu.Lock()
+	defer c.bannersMu.Unlock()
+
+	banners, err := c.GetBanners(ctx)
+	if err != nil {
+		return err
+	}
+
+	kept := make([]Banner, 0, len(banners))
+	for _, banner := range banners {
+		if banner.ID != id {
+			kept = append(kept, banner)
+		}
+	}
+	if len(kept) == len(banners) {
+		return nil
+	}
+
+	return c.setBanners(ctx, kept)
+}
+
+// setBanners replaces the list of banners. OpenWebUI only stores the full
+// list, so callers must hold bannersMu between reading and writing it.
+func (c *Client) setBanners(ctx context.Context, banners []Banner) error {
+	return c.do(ctx, "POST", "/api/v1/configs/banners", map[string]interface{}{"banners": banners}, nil)
+}
@@ -0,0 +1,178 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package configs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/internal/rest"
+)
+
+// Client implements the admin configuration operations
+type Client struct {
+	api *rest.Client
+
+	// bannersMu serializes changes to the list of banners, which can only be
+	// replaced as a whole
+	bannersMu sync.Mutex
+	// connectionsMu serializes changes to the lists of API connections
+	connectionsMu sync.Mutex
+	// importMu gives imports of the full configuration exclusive access, so
+	// no other change lands between their export and import and is overwritten
+	// with the exported value. Other changes only hold it for reading.
+	importMu sync.RWMutex
+	// endpointsMu guards endpoints, which holds a mutex per settings endpoint
+	// serializing the read-modify-write cycles on it
+	endpointsMu sync.Mutex
+	endpoints   map[string]*sync.Mutex
+}
+
+// NewClient creates a new configs client
+func NewClient(endpoint, token string, httpClient *http.Client) *Client {
+	return &Client{api: rest.New(endpoint, token, httpClient)}
+}
+
+// GetToolServers gets the configured tool server connections
+func (c *Client) GetToolServers(ctx context.Context) (*ToolServersConfig, error) {
+	var result ToolServersConfig
+	if err := c.api.Do(ctx, "GET", "/api/v1/configs/tool_servers", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Export gets the full admin configuration of the instance, keyed by section
+func (c *Client) Export(ctx context.Context) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	if err := c.api.Do(ctx, "GET", "/api/v1/configs/export", nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// importSection changes the settings of a section of the exported
+// configuration that has no dedicated endpoint. The full configuration is
+// exported, the non-nil fields of form are merged into the section and the
+// result is imported again.
+func (c *Client) importSection(ctx context.Context, section string, form interface{}) error {
+	c.importMu.Lock()
+	defer c.importMu.Unlock()
+
+	config, err := c.Export(ctx)
+	if err != nil {
+		return err
+	}
+
+	changes, err := toMap(map[string]interface{}{section: form})
+	if err != nil {
+		return err
+	}
+
+	// importMu is already held, so send directly instead of through do
+	return c.api.Do(ctx, "POST", "/api/v1/configs/import", map[string]interface{}{"config": merge(config, changes)}, nil)
+}
+
+// update changes the settings in form with a read-modify-write cycle: the
+// current configuration is read from getPath, the non-nil fields of form are
+// merged into it and the result is posted to postPath. Sending back the full
+// configuration keeps settings this client does not model, since most update
+// endpoints replace the whole section.
+func (c *Client) update(ctx context.Context, getPath, postPath string, form interface{}, out interface{}) error {
+	mu := c.endpointMu(getPath)
+	mu.Lock()
+	defer mu.Unlock()
+
+	var current map[string]interface{}
+	if err := c.do(ctx, "GET", getPath, nil, &current); err != nil {
+		return err
+	}
+
+	changes, err := toMap(form)
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, "POST", postPath, merge(current, changes), out)
+}
+
+// endpointMu returns the mutex serializing the read-modify-write cycles on the
+// settings read from path, as several resources can change the same settings,
+// e.g. the RAG and web search settings.
+func (c *Client) endpointMu(path string) *sync.Mutex {
+	c.endpointsMu.Lock()
+	defer c.endpointsMu.Unlock()
+
+	if c.endpoints == nil {
+		c.endpoints = make(map[string]*sync.Mutex)
+	}
+	mu, ok := c.endpoints[path]
+	if !ok {
+		mu = &sync.Mutex{}
+		c.endpoints[path] = mu
+	}
+	return mu
+}
+
+// do sends a request with in as JSON body, unless it is nil, and decodes the
+// response into out, unless it is nil. Changes wait for running imports of
+// the full configuration.
+func (c *Client) do(ctx context.Context, method, path string, in interface{}, out interface{}) error {
+	if method != "GET" {
+		c.importMu.RLock()
+		defer c.importMu.RUnlock()
+	}
+	return c.api.Do(ctx, method, path, in, out)
+}
+
+// toMap converts a form into its JSON object representation
+func toMap(form interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(form)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding request: %v", err)
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("error encoding request: %v", err)
+	}
+	return m, nil
+}
+
+// convert decodes a generic JSON value, e.g. a section of the exported
+// configuration, into out
+func convert(value interface{}, out interface{}) error {
+	if value == nil {
+		return nil
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("error decoding response: %v", err)
+	}
+	if err := json.Unmarshal(b, out); err != nil {
+		return fmt.Errorf("error decoding response: %v", err)
+	}
+	return nil
+}
+
+// merge copies the values of changes into current, merging nested objects
+// instead of replacing them
+func merge(current, changes map[string]interface{}) map[string]interface{} {
+	if current == nil {
+		current = make(map[string]interface{}, len(changes))
+	}
+	for key, value := range changes {
+		if nested, ok := value.(map[string]interface{}); ok {
+			if existing, ok := current[key].(map[string]interface{}); ok {
+				current[key] = merge(existing, nested)
+				continue
+			}
+		}
+		current[key] = value
+	}
+	return current
+}
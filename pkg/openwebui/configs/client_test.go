@@ -0,0 +1,543 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package configs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+)
+
+func TestUpdateAuthConfigPreservesUnmodeledSettings(t *testing.T) {
+	var sent map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/auths/admin/config" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == "POST" {
+			_ = json.NewDecoder(r.Body).Decode(&sent)
+			_ = json.NewEncoder(w).Encode(sent)
+			return
+		}
+		_, _ = w.Write([]byte(`{"ENABLE_SIGNUP":true,"ENABLE_API_KEY":true,"DEFAULT_USER_ROLE":"user","JWT_EXPIRES_IN":"-1","ENABLE_CHANNELS":true}`))
+	}))
+	defer ts.Close()
+
+	enableSignup := false
+	role := "pending"
+	config, err := NewClient(ts.URL, "token", ts.Client()).UpdateAuthConfig(context.Background(), &AuthConfig{
+		EnableSignup:    &enableSignup,
+		DefaultUserRole: &role,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if sent["ENABLE_SIGNUP"] != false || sent["DEFAULT_USER_ROLE"] != "pending" {
+		t.Errorf("changed settings were not sent: %v", sent)
+	}
+	if sent["ENABLE_API_KEY"] != true || sent["JWT_EXPIRES_IN"] != "-1" || sent["ENABLE_CHANNELS"] != true {
+		t.Errorf("unchanged settings were not sent back: %v", sent)
+	}
+	if *config.EnableSignup || *config.DefaultUserRole != "pending" {
+		t.Errorf("config = %+v, want the updated settings", config)
+	}
+}
+
+func TestMergeNestedObjects(t *testing.T) {
+	current := map[string]interface{}{
+		"web":   map[string]interface{}{"ENABLE_WEB_SEARCH": false, "SEARXNG_QUERY_URL": "http://searxng"},
+		"TOP_K": 3.0,
+	}
+	merged := merge(current, map[string]interface{}{
+		"web": map[string]interface{}{"ENABLE_WEB_SEARCH": true},
+	})
+
+	web := merged["web"].(map[string]interface{})
+	if web["ENABLE_WEB_SEARCH"] != true || web["SEARXNG_QUERY_URL"] != "http://searxng" || merged["TOP_K"] != 3.0 {
+		t.Errorf("merged = %v", merged)
+	}
+}
+
+func TestUpdateOAuthConfigImportsFullConfig(t *testing.T) {
+	stored := map[string]interface{}{
+		"version": 0.0,
+		"ui":      map[string]interface{}{"enable_signup": false},
+		"oauth":   map[string]interface{}{"merge_accounts_by_email": false, "oidc": map[string]interface{}{"client_id": "old", "scopes": "openid email profile"}},
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/configs/export":
+			_ = json.NewEncoder(w).Encode(stored)
+		case "/api/v1/configs/import":
+			var form struct {
+				Config map[string]interface{} `json:"config"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&form)
+			stored = form.Config
+			_ = json.NewEncoder(w).Encode(stored)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	clientID := "openwebui"
+	config, err := NewClient(ts.URL, "token", ts.Client()).UpdateOAuthConfig(context.Background(), &OAuthConfig{
+		OIDC: &OIDCConfig{ClientID: &clientID},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if *config.OIDC.ClientID != "openwebui" || *config.OIDC.Scopes != "openid email profile" || *config.MergeAccountsByEmail {
+		t.Errorf("config = %+v, want only the client ID changed", config.OIDC)
+	}
+	if ui, _ := stored["ui"].(map[string]interface{}); ui["enable_signup"] != false {
+		t.Errorf("other sections were not imported again: %v", stored)
+	}
+}
+
+func TestUpdateOAuthConfigKeepsConcurrentChanges(t *testing.T) {
+	var mu sync.Mutex
+	stored := map[string]interface{}{
+		"ui":    map[string]interface{}{"enable_signup": true},
+		"oauth": map[string]interface{}{"merge_accounts_by_email": false},
+	}
+	exported := make(chan struct{})
+	var once sync.Once
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/configs/export":
+			mu.Lock()
+			body, _ := json.Marshal(stored)
+			mu.Unlock()
+			_, _ = w.Write(body)
+			// Give the other change time to land before the import
+			once.Do(func() { close(exported) })
+			time.Sleep(50 * time.Millisecond)
+		case "/api/v1/configs/import":
+			var form struct {
+				Config map[string]interface{} `json:"config"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&form)
+			mu.Lock()
+			stored = form.Config
+			mu.Unlock()
+			_, _ = w.Write([]byte(`{}`))
+		case "/api/v1/auths/admin/config":
+			mu.Lock()
+			defer mu.Unlock()
+			ui := stored["ui"].(map[string]interface{})
+			if r.Method == "POST" {
+				var form map[string]interface{}
+				_ = json.NewDecoder(r.Body).Decode(&form)
+				ui["enable_signup"] = form["ENABLE_SIGNUP"]
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"ENABLE_SIGNUP": ui["enable_signup"]})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	client := NewClient(ts.URL, "token", ts.Client())
+
+	errs := make(chan error, 1)
+	go func() {
+		merge := true
+		_, err := client.UpdateOAuthConfig(context.Background(), &OAuthConfig{MergeAccountsByEmail: &merge})
+		errs <- err
+	}()
+	<-exported
+
+	enableSignup := false
+	if _, err := client.UpdateAuthConfig(context.Background(), &AuthConfig{EnableSignup: &enableSignup}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if oauth, _ := stored["oauth"].(map[string]interface{}); oauth["merge_accounts_by_email"] != true {
+		t.Errorf("oauth change was lost: %v", stored)
+	}
+	if ui, _ := stored["ui"].(map[string]interface{}); ui["enable_signup"] != false {
+		t.Errorf("concurrent auth change was overwritten by the import: %v", stored)
+	}
+}
+
+func TestUpdateInterfaceConfigReplacesSuggestions(t *testing.T) {
+	stored := map[string]interface{}{
+		"ui": map[string]interface{}{
+			"enable_signup":      false,
+			"prompt_suggestions": []interface{}{map[string]interface{}{"title": []interface{}{"Old", "one"}, "content": "old"}},
+		},
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/configs/export":
+			_ = json.NewEncoder(w).Encode(stored)
+		case "/api/v1/configs/import":
+			var form struct {
+				Config map[string]interface{} `json:"config"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&form)
+			stored = form.Config
+			_ = json.NewEncoder(w).Encode(stored)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	config, err := NewClient(ts.URL, "token", ts.Client()).UpdateInterfaceConfig(context.Background(), &InterfaceConfig{
+		PromptSuggestions: &[]PromptSuggestion{{Title: []string{"New"}, Content: "new"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(*config.PromptSuggestions) != 1 || (*config.PromptSuggestions)[0].Content != "new" {
+		t.Errorf("suggestions = %+v, want only the new suggestion", *config.PromptSuggestions)
+	}
+	if ui, _ := stored["ui"].(map[string]interface{}); ui["enable_signup"] != false {
+		t.Errorf("other interface settings were not kept: %v", stored)
+	}
+}
+
+func TestUpdateInterfaceConfigKeepsConcurrentOAuthChanges(t *testing.T) {
+	var mu sync.Mutex
+	stored := map[string]interface{}{
+		"ui":    map[string]interface{}{"enable_signup": true, "prompt_suggestions": []interface{}{}},
+		"oauth": map[string]interface{}{"merge_accounts_by_email": false},
+	}
+	exported := make(chan struct{})
+	var once sync.Once
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/configs/export":
+			mu.Lock()
+			body, _ := json.Marshal(stored)
+			mu.Unlock()
+			_, _ = w.Write(body)
+			// Give the other apply time to export before the import
+			once.Do(func() { close(exported) })
+			time.Sleep(50 * time.Millisecond)
+		case "/api/v1/configs/import":
+			var form struct {
+				Config map[string]interface{} `json:"config"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&form)
+			mu.Lock()
+			stored = form.Config
+			mu.Unlock()
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	client := NewClient(ts.URL, "token", ts.Client())
+
+	errs := make(chan error, 1)
+	go func() {
+		_, err := client.UpdateInterfaceConfig(context.Background(), &InterfaceConfig{
+			PromptSuggestions: &[]PromptSuggestion{{Title: []string{"New"}, Content: "new"}},
+		})
+		errs <- err
+	}()
+	<-exported
+
+	merge := true
+	if _, err := client.UpdateOAuthConfig(context.Background(), &OAuthConfig{MergeAccountsByEmail: &merge}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if ui, _ := stored["ui"].(map[string]interface{}); len(ui["prompt_suggestions"].([]interface{})) != 1 {
+		t.Errorf("interface change was lost: %v", stored)
+	}
+	if oauth, _ := stored["oauth"].(map[string]interface{}); oauth["merge_accounts_by_email"] != true {
+		t.Errorf("concurrent oauth change was lost: %v", stored)
+	}
+}
+
+func TestUpdateWebSearchConfigStoresKeyOfEngine(t *testing.T) {
+	stored := map[string]interface{}{
+		"TOP_K": 3.0,
+		"web":   map[string]interface{}{"ENABLE_WEB_SEARCH": false, "WEB_SEARCH_ENGINE": "searxng", "BRAVE_SEARCH_API_KEY": ""},
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/retrieval/config":
+			_ = json.NewEncoder(w).Encode(stored)
+		case "/api/v1/retrieval/config/update":
+			stored = nil
+			_ = json.NewDecoder(r.Body).Decode(&stored)
+			_ = json.NewEncoder(w).Encode(stored)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	enabled := true
+	engine := "brave"
+	key := "brave-key"
+	config, err := NewClient(ts.URL, "token", ts.Client()).UpdateWebSearchConfig(context.Background(), &WebSearchConfig{
+		Enabled: &enabled,
+		Engine:  &engine,
+		APIKey:  &key,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	web := stored["web"].(map[string]interface{})
+	if web["BRAVE_SEARCH_API_KEY"] != "brave-key" || web["WEB_SEARCH_ENGINE"] != "brave" || stored["TOP_K"] != 3.0 {
+		t.Errorf("stored = %v", stored)
+	}
+	if config.APIKey == nil || *config.APIKey != "brave-key" {
+		t.Errorf("APIKey = %v, want the key of the brave engine", config.APIKey)
+	}
+}
+
+func TestUpdateWebSearchConfigKeepsConcurrentRAGChanges(t *testing.T) {
+	ts, read, settings := newSlowSettingsServer("/api/v1/retrieval/config", "/api/v1/retrieval/config/update", map[string]interface{}{
+		"TOP_K": 3.0,
+		"web":   map[string]interface{}{"ENABLE_WEB_SEARCH": false, "WEB_SEARCH_ENGINE": "searxng"},
+	})
+	defer ts.Close()
+	client := NewClient(ts.URL, "token", ts.Client())
+
+	errs := make(chan error, 1)
+	go func() {
+		topK := int64(5)
+		_, err := client.UpdateRAGConfig(context.Background(), &RAGConfig{TopK: &topK})
+		errs <- err
+	}()
+	<-read
+
+	enabled := true
+	if _, err := client.UpdateWebSearchConfig(context.Background(), &WebSearchConfig{Enabled: &enabled}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	stored := settings()
+	if stored["TOP_K"] != 5.0 {
+		t.Errorf("RAG change was lost: %v", stored)
+	}
+	if web, _ := stored["web"].(map[string]interface{}); web["ENABLE_WEB_SEARCH"] != true {
+		t.Errorf("concurrent web search change was reverted: %v", stored)
+	}
+}
+
+func TestUpdateModelsConfigKeepsConcurrentChanges(t *testing.T) {
+	ts, read, settings := newSlowSettingsServer("/api/v1/configs/models", "/api/v1/configs/models", map[string]interface{}{
+		"DEFAULT_MODELS":   "",
+		"MODEL_ORDER_LIST": []interface{}{},
+	})
+	defer ts.Close()
+	client := NewClient(ts.URL, "token", ts.Client())
+
+	errs := make(chan error, 1)
+	go func() {
+		order := []string{"gpt-4o", "llama3"}
+		_, err := client.UpdateModelsConfig(context.Background(), &ModelsConfig{ModelOrderList: &order})
+		errs <- err
+	}()
+	<-read
+
+	defaults := "llama3"
+	if _, err := client.UpdateModelsConfig(context.Background(), &ModelsConfig{DefaultModels: &defaults}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	stored := settings()
+	if order, _ := stored["MODEL_ORDER_LIST"].([]interface{}); len(order) != 2 {
+		t.Errorf("model order change was lost: %v", stored)
+	}
+	if stored["DEFAULT_MODELS"] != "llama3" {
+		t.Errorf("concurrent default models change was reverted: %v", stored)
+	}
+}
+
+// newSlowSettingsServer serves settings read from getPath and posted back to
+// postPath. The first read is delayed after signalling read, giving a
+// concurrent change the chance to land before that read is posted back.
+func newSlowSettingsServer(getPath, postPath string, stored map[string]interface{}) (*httptest.Server, <-chan struct{}, func() map[string]interface{}) {
+	var mu sync.Mutex
+	read := make(chan struct{})
+	var once sync.Once
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == getPath:
+			mu.Lock()
+			body, _ := json.Marshal(stored)
+			mu.Unlock()
+			_, _ = w.Write(body)
+			once.Do(func() {
+				close(read)
+				time.Sleep(50 * time.Millisecond)
+			})
+		case r.Method == "POST" && r.URL.Path == postPath:
+			var form map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&form)
+			mu.Lock()
+			stored = form
+			mu.Unlock()
+			_ = json.NewEncoder(w).Encode(form)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return ts, read, func() map[string]interface{} {
+		mu.Lock()
+		defer mu.Unlock()
+		return stored
+	}
+}
+
+func TestBannersKeepOtherBanners(t *testing.T) {
+	stored := []Banner{{ID: "manual", Type: "info", Content: "added in the admin panel"}}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/configs/banners" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == "POST" {
+			var form struct {
+				Banners []Banner `json:"banners"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&form)
+			stored = form.Banners
+		}
+		_ = json.NewEncoder(w).Encode(stored)
+	}))
+	defer ts.Close()
+
+	client := NewClient(ts.URL, "token", ts.Client())
+	ctx := context.Background()
+
+	if _, err := client.SetBanner(ctx, &Banner{ID: "tf", Type: "warning", Content: "maintenance"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	banner, err := client.SetBanner(ctx, &Banner{ID: "tf", Type: "error", Content: "outage"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(stored) != 2 || banner.Type != "error" {
+		t.Errorf("banners = %+v, want the manual banner and the updated banner", stored)
+	}
+
+	if err := client.DeleteBanner(ctx, "tf"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(stored) != 1 || stored[0].ID != "manual" {
+		t.Errorf("banners = %+v, want only the manual banner", stored)
+	}
+	if _, err := client.GetBanner(ctx, "tf"); !errors.Is(err, apierror.ErrNotFound) {
+		t.Errorf("GetBanner() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestOpenAIConnectionsKeepPositions(t *testing.T) {
+	stored := openAIConfig{
+		BaseURLs: []string{"https://api.openai.com/v1", "http://pipelines:9099"},
+		Keys:     []string{"sk-openai", "pipelines-key"},
+		Configs:  map[string]map[string]interface{}{"1": {"enable": true, "tags": []interface{}{"internal"}}},
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/openai/config":
+		case "/openai/config/update":
+			stored = openAIConfig{}
+			_ = json.NewDecoder(r.Body).Decode(&stored)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(stored)
+	}))
+	defer ts.Close()
+
+	client := NewClient(ts.URL, "token", ts.Client())
+	ctx := context.Background()
+
+	key := "sk-azure"
+	prefix := "azure"
+	conn, err := client.SetOpenAIConnection(ctx, &Connection{URL: "https://azure.example.com/openai", Key: &key, PrefixID: &prefix})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *conn.Key != "sk-azure" || *conn.PrefixID != "azure" || !*conn.Enable {
+		t.Errorf("connection = %+v, want the new key and prefix", conn)
+	}
+
+	if err := client.DeleteOpenAIConnection(ctx, "https://api.openai.com/v1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(stored.BaseURLs) != 2 || stored.Keys[0] != "pipelines-key" || stored.Configs["0"]["tags"] == nil || stored.Configs["1"]["prefix_id"] != "azure" {
+		t.Errorf("config = %+v, want the remaining connections moved up with their keys and configs", stored)
+	}
+}
+
+func TestOllamaConnectionKeyInConfig(t *testing.T) {
+	stored := ollamaConfig{BaseURLs: []string{"http://ollama:11434"}}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ollama/config":
+		case "/ollama/config/update":
+			stored = ollamaConfig{}
+			_ = json.NewDecoder(r.Body).Decode(&stored)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(stored)
+	}))
+	defer ts.Close()
+
+	client := NewClient(ts.URL, "token", ts.Client())
+	ctx := context.Background()
+
+	key := "ollama-key"
+	conn, err := client.SetOllamaConnection(ctx, &Connection{URL: "https://gpu.example.com/", Key: &key})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *conn.Key != "ollama-key" || !*conn.Enable {
+		t.Errorf("connection = %+v, want the new key", conn)
+	}
+	if stored.Configs["1"]["key"] != "ollama-key" {
+		t.Errorf("config = %+v, want the key in the config of the connection", stored)
+	}
+
+	if _, err := client.GetOllamaConnection(ctx, "https://gpu.example.com"); err != nil {
+		t.Errorf("unexpected error for URL without trailing slash: %v", err)
+	}
+	if _, err := client.GetOllamaConnection(ctx, "http://other:11434"); !errors.Is(err, apierror.ErrNotFound) {
+		t.Errorf("err = %v, want ErrNotFound", err)
+	}
+}
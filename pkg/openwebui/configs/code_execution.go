@@ -0,0 +1,45 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package configs
+
+import "context"
+
+// CodeExecutionConfig represents the settings for running code blocks of
+// responses and the code interpreter. Nil fields are left unchanged by
+// UpdateCodeExecutionConfig.
+type CodeExecutionConfig struct {
+	EnableCodeExecution            *bool   `json:"ENABLE_CODE_EXECUTION,omitempty"`
+	CodeExecutionEngine            *string `json:"CODE_EXECUTION_ENGINE,omitempty"`
+	CodeExecutionJupyterURL        *string `json:"CODE_EXECUTION_JUPYTER_URL,omitempty"`
+	CodeExecutionJupyterAuth       *string `json:"CODE_EXECUTION_JUPYTER_AUTH,omitempty"`
+	CodeExecutionJupyterToken      *string `json:"CODE_EXECUTION_JUPYTER_AUTH_TOKEN,omitempty"`
+	CodeExecutionJupyterPassword   *string `json:"CODE_EXECUTION_JUPYTER_AUTH_PASSWORD,omitempty"`
+	CodeExecutionJupyterTimeout    *int64  `json:"CODE_EXECUTION_JUPYTER_TIMEOUT,omitempty"`
+	EnableCodeInterpreter          *bool   `json:"ENABLE_CODE_INTERPRETER,omitempty"`
+	CodeInterpreterEngine          *string `json:"CODE_INTERPRETER_ENGINE,omitempty"`
+	CodeInterpreterPromptTemplate  *string `json:"CODE_INTERPRETER_PROMPT_TEMPLATE,omitempty"`
+	CodeInterpreterJupyterURL      *string `json:"CODE_INTERPRETER_JUPYTER_URL,omitempty"`
+	CodeInterpreterJupyterAuth     *string `json:"CODE_INTERPRETER_JUPYTER_AUTH,omitempty"`
+	CodeInterpreterJupyterToken    *string `json:"CODE_INTERPRETER_JUPYTER_AUTH_TOKEN,omitempty"`
+	CodeInterpreterJupyterPassword *string `json:"CODE_INTERPRETER_JUPYTER_AUTH_PASSWORD,omitempty"`
+	CodeInterpreterJupyterTimeout  *int64  `json:"CODE_INTERPRETER_JUPYTER_TIMEOUT,omitempty"`
+}
+
+// GetCodeExecutionConfig gets the code execution and code interpreter settings
+func (c *Client) GetCodeExecutionConfig(ctx context.Context) (*CodeExecutionConfig, error) {
+	var result CodeExecutionConfig
+	if err := c.do(ctx, "GET", "/api/v1/configs/code_execution", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateCodeExecutionConfig changes the code execution and code interpreter settings
+func (c *Client) UpdateCodeExecutionConfig(ctx context.Context, form *CodeExecutionConfig) (*CodeExecutionConfig, error) {
+	var result CodeExecutionConfig
+	if err := c.update(ctx, "/api/v1/configs/code_execution", "/api/v1/configs/code_execution", form, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,111 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package configs
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+)
+
+// Connection represents an OpenAI compatible or Ollama API connection. The
+// URL identifies the connection. Nil fields are left unchanged when the
+// connection is set.
+type Connection struct {
+	URL      string
+	Key      *string
+	Enable   *bool
+	PrefixID *string
+	// ModelIDs limits the models offered by the connection, an empty list offers all models
+	ModelIDs *[]string
+}
+
+// connectionConfig is the per connection part of Connection. Connection
+// configs are keyed by the position of the connection as a string.
+type connectionConfig struct {
+	Enable   *bool     `json:"enable,omitempty"`
+	PrefixID *string   `json:"prefix_id,omitempty"`
+	ModelIDs *[]string `json:"model_ids,omitempty"`
+	Key      *string   `json:"key,omitempty"`
+}
+
+// connectionIndex returns the position of the connection with the given URL
+func connectionIndex(urls []string, url string) (int, error) {
+	for i, u := range urls {
+		if strings.TrimRight(u, "/") == strings.TrimRight(url, "/") {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("connection %s: %w", url, apierror.ErrNotFound)
+}
+
+// connectionFromConfig builds the Connection at position idx
+func connectionFromConfig(url string, key *string, configs map[string]map[string]interface{}, idx int) (*Connection, error) {
+	var config connectionConfig
+	if err := convert(configs[strconv.Itoa(idx)], &config); err != nil {
+		return nil, err
+	}
+
+	if key == nil {
+		key = config.Key
+	}
+	if config.Enable == nil {
+		// Connections without a config are enabled
+		enable := true
+		config.Enable = &enable
+	}
+	if config.ModelIDs == nil {
+		config.ModelIDs = &[]string{}
+	}
+
+	return &Connection{
+		URL:      url,
+		Key:      key,
+		Enable:   config.Enable,
+		PrefixID: config.PrefixID,
+		ModelIDs: config.ModelIDs,
+	}, nil
+}
+
+// setConnectionConfig merges the settings of conn into the config at position
+// idx. The key is only stored in the config when keyInConfig is set.
+func setConnectionConfig(configs map[string]map[string]interface{}, idx int, conn *Connection, keyInConfig bool) (map[string]map[string]interface{}, error) {
+	form := connectionConfig{
+		Enable:   conn.Enable,
+		PrefixID: conn.PrefixID,
+		ModelIDs: conn.ModelIDs,
+	}
+	if keyInConfig {
+		form.Key = conn.Key
+	}
+
+	changes, err := toMap(form)
+	if err != nil {
+		return nil, err
+	}
+
+	if configs == nil {
+		configs = make(map[string]map[string]interface{})
+	}
+	configs[strconv.Itoa(idx)] = merge(configs[strconv.Itoa(idx)], changes)
+	return configs, nil
+}
+
+// removeConnectionConfig removes the config at position idx and moves the
+// configs of the following connections up by one.
+func removeConnectionConfig(configs map[string]map[string]interface{}, idx int) map[string]map[string]interface{} {
+	result := make(map[string]map[string]interface{}, len(configs))
+	for key, config := range configs {
+		i, err := strconv.Atoi(key)
+		switch {
+		case err != nil || i < idx:
+			result[key] = config
+		case i > idx:
+			result[strconv.Itoa(i-1)] = config
+		}
+	}
+	return result
+}
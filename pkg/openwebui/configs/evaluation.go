@@ -0,0 +1,48 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package configs
+
+import "context"
+
+// EvaluationConfig represents the arena settings. Nil fields are left
+// unchanged by UpdateEvaluationConfig.
+type EvaluationConfig struct {
+	EnableArenaModels *bool         `json:"ENABLE_EVALUATION_ARENA_MODELS,omitempty"`
+	ArenaModels       *[]ArenaModel `json:"EVALUATION_ARENA_MODELS,omitempty"`
+}
+
+// ArenaModel represents a model that answers with a randomly selected model,
+// so that users can rate the answers without knowing the model
+type ArenaModel struct {
+	ID   string         `json:"id"`
+	Name string         `json:"name"`
+	Meta ArenaModelMeta `json:"meta"`
+}
+
+// ArenaModelMeta represents the settings of an arena model
+type ArenaModelMeta struct {
+	ProfileImageURL *string `json:"profile_image_url,omitempty"`
+	Description     *string `json:"description,omitempty"`
+	// ModelIDs limits the models the arena model selects from, nil selects from all models
+	ModelIDs []string `json:"model_ids"`
+}
+
+// GetEvaluationConfig gets the arena settings
+func (c *Client) GetEvaluationConfig(ctx context.Context) (*EvaluationConfig, error) {
+	var result EvaluationConfig
+	if err := c.do(ctx, "GET", "/api/v1/evaluations/config", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateEvaluationConfig changes the arena settings. ArenaModels replaces
+// all arena models.
+func (c *Client) UpdateEvaluationConfig(ctx context.Context, form *EvaluationConfig) (*EvaluationConfig, error) {
+	var result EvaluationConfig
+	if err := c.update(ctx, "/api/v1/evaluations/config", "/api/v1/evaluations/config", form, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
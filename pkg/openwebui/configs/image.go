@@ -0,0 +1,99 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package configs
+
+import "context"
+
+// ImageConfig represents the image generation engine settings. Nil fields are
+// left unchanged by UpdateImageConfig.
+type ImageConfig struct {
+	Enabled          *bool               `json:"enabled,omitempty"`
+	Engine           *string             `json:"engine,omitempty"`
+	PromptGeneration *bool               `json:"prompt_generation,omitempty"`
+	OpenAI           *ImageOpenAIConfig  `json:"openai,omitempty"`
+	Automatic1111    *ImageA1111Config   `json:"automatic1111,omitempty"`
+	ComfyUI          *ImageComfyUIConfig `json:"comfyui,omitempty"`
+	Gemini           *ImageGeminiConfig  `json:"gemini,omitempty"`
+}
+
+// ImageOpenAIConfig represents the connection used by the openai engine
+type ImageOpenAIConfig struct {
+	BaseURL *string `json:"OPENAI_API_BASE_URL,omitempty"`
+	APIKey  *string `json:"OPENAI_API_KEY,omitempty"`
+}
+
+// ImageA1111Config represents the connection used by the automatic1111 engine
+type ImageA1111Config struct {
+	BaseURL *string `json:"AUTOMATIC1111_BASE_URL,omitempty"`
+	APIAuth *string `json:"AUTOMATIC1111_API_AUTH,omitempty"`
+}
+
+// ImageComfyUIConfig represents the connection used by the comfyui engine
+type ImageComfyUIConfig struct {
+	BaseURL  *string `json:"COMFYUI_BASE_URL,omitempty"`
+	APIKey   *string `json:"COMFYUI_API_KEY,omitempty"`
+	Workflow *string `json:"COMFYUI_WORKFLOW,omitempty"`
+}
+
+// ImageGeminiConfig represents the connection used by the gemini engine
+type ImageGeminiConfig struct {
+	BaseURL *string `json:"GEMINI_API_BASE_URL,omitempty"`
+	APIKey  *string `json:"GEMINI_API_KEY,omitempty"`
+}
+
+// ImageGenerationConfig represents the default model and image parameters.
+// Nil fields are left unchanged by UpdateImageGenerationConfig.
+type ImageGenerationConfig struct {
+	Model *string `json:"MODEL,omitempty"`
+	Size  *string `json:"IMAGE_SIZE,omitempty"`
+	Steps *int64  `json:"IMAGE_STEPS,omitempty"`
+}
+
+// GetImageConfig gets the image generation engine settings
+func (c *Client) GetImageConfig(ctx context.Context) (*ImageConfig, error) {
+	var result ImageConfig
+	if err := c.do(ctx, "GET", "/api/v1/images/config", nil, &result); err != nil {
+		return nil, err
+	}
+	if result.OpenAI == nil {
+		result.OpenAI = &ImageOpenAIConfig{}
+	}
+	if result.Automatic1111 == nil {
+		result.Automatic1111 = &ImageA1111Config{}
+	}
+	if result.ComfyUI == nil {
+		result.ComfyUI = &ImageComfyUIConfig{}
+	}
+	if result.Gemini == nil {
+		result.Gemini = &ImageGeminiConfig{}
+	}
+	return &result, nil
+}
+
+// UpdateImageConfig changes the image generation engine settings
+func (c *Client) UpdateImageConfig(ctx context.Context, form *ImageConfig) (*ImageConfig, error) {
+	if err := c.update(ctx, "/api/v1/images/config", "/api/v1/images/config/update", form, nil); err != nil {
+		return nil, err
+	}
+	return c.GetImageConfig(ctx)
+}
+
+// GetImageGenerationConfig gets the default model and image parameters
+func (c *Client) GetImageGenerationConfig(ctx context.Context) (*ImageGenerationConfig, error) {
+	var result ImageGenerationConfig
+	if err := c.do(ctx, "GET", "/api/v1/images/image/config", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateImageGenerationConfig changes the default model and image parameters.
+// The engine must be configured first, since OpenWebUI validates the model with it.
+func (c *Client) UpdateImageGenerationConfig(ctx context.Context, form *ImageGenerationConfig) (*ImageGenerationConfig, error) {
+	var result ImageGenerationConfig
+	if err := c.update(ctx, "/api/v1/images/image/config", "/api/v1/images/image/config/update", form, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
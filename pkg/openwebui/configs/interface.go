@@ -0,0 +1,45 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package configs
+
+import "context"
+
+// InterfaceConfig represents the interface settings, found under "ui" in the
+// exported configuration. Nil fields are left unchanged by UpdateInterfaceConfig.
+type InterfaceConfig struct {
+	DefaultLocale     *string             `json:"default_locale,omitempty"`
+	PromptSuggestions *[]PromptSuggestion `json:"prompt_suggestions,omitempty"`
+}
+
+// PromptSuggestion represents a prompt suggested on the start page of a new
+// chat. Title holds the title and optionally a subtitle.
+type PromptSuggestion struct {
+	Title   []string `json:"title"`
+	Content string   `json:"content"`
+}
+
+// GetInterfaceConfig gets the interface settings
+func (c *Client) GetInterfaceConfig(ctx context.Context) (*InterfaceConfig, error) {
+	config, err := c.Export(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result InterfaceConfig
+	if err := convert(config["ui"], &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateInterfaceConfig changes the interface settings. The dedicated
+// endpoint only covers prompt suggestions, so the full configuration is
+// exported, changed and imported again.
+func (c *Client) UpdateInterfaceConfig(ctx context.Context, form *InterfaceConfig) (*InterfaceConfig, error) {
+	if err := c.importSection(ctx, "ui", form); err != nil {
+		return nil, err
+	}
+
+	return c.GetInterfaceConfig(ctx)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package configs
+
+import "context"
+
+// LDAPServerConfig represents the connection settings of the LDAP server. Nil
+// fields are left unchanged by UpdateLDAPServer.
+type LDAPServerConfig struct {
+	Label                *string `json:"label,omitempty"`
+	Host                 *string `json:"host,omitempty"`
+	Port                 *int64  `json:"port,omitempty"`
+	AttributeForMail     *string `json:"attribute_for_mail,omitempty"`
+	AttributeForUsername *string `json:"attribute_for_username,omitempty"`
+	AppDN                *string `json:"app_dn,omitempty"`
+	AppDNPassword        *string `json:"app_dn_password,omitempty"`
+	SearchBase           *string `json:"search_base,omitempty"`
+	SearchFilters        *string `json:"search_filters,omitempty"`
+	UseTLS               *bool   `json:"use_tls,omitempty"`
+	CertificatePath      *string `json:"certificate_path,omitempty"`
+	ValidateCert         *bool   `json:"validate_cert,omitempty"`
+	Ciphers              *string `json:"ciphers,omitempty"`
+}
+
+// ldapConfig represents whether LDAP authentication is enabled. The server
+// reports it as ENABLE_LDAP but expects enable_ldap in updates.
+type ldapConfig struct {
+	EnableLDAP bool `json:"ENABLE_LDAP"`
+}
+
+type ldapConfigForm struct {
+	EnableLDAP bool `json:"enable_ldap"`
+}
+
+// GetLDAPServer gets the connection settings of the LDAP server
+func (c *Client) GetLDAPServer(ctx context.Context) (*LDAPServerConfig, error) {
+	var result LDAPServerConfig
+	if err := c.do(ctx, "GET", "/api/v1/auths/admin/config/ldap/server", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateLDAPServer changes the connection settings of the LDAP server
+func (c *Client) UpdateLDAPServer(ctx context.Context, form *LDAPServerConfig) (*LDAPServerConfig, error) {
+	path := "/api/v1/auths/admin/config/ldap/server"
+	if err := c.update(ctx, path, path, form, nil); err != nil {
+		return nil, err
+	}
+	// Read the settings back to get the values the server stored
+	return c.GetLDAPServer(ctx)
+}
+
+// GetLDAPEnabled reports whether LDAP authentication is enabled
+func (c *Client) GetLDAPEnabled(ctx context.Context) (bool, error) {
+	var result ldapConfig
+	if err := c.do(ctx, "GET", "/api/v1/auths/admin/config/ldap", nil, &result); err != nil {
+		return false, err
+	}
+	return result.EnableLDAP, nil
+}
+
+// SetLDAPEnabled enables or disables LDAP authentication
+func (c *Client) SetLDAPEnabled(ctx context.Context, enabled bool) error {
+	return c.do(ctx, "POST", "/api/v1/auths/admin/config/ldap", &ldapConfigForm{EnableLDAP: enabled}, nil)
+}
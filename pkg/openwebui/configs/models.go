@@ -0,0 +1,57 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package configs
+
+import (
+	"context"
+	"strings"
+)
+
+// ModelsConfig represents the instance wide model selection settings. Nil
+// fields are left unchanged by UpdateModelsConfig.
+type ModelsConfig struct {
+	// DefaultModels is a comma separated list of model IDs
+	DefaultModels  *string   `json:"DEFAULT_MODELS,omitempty"`
+	ModelOrderList *[]string `json:"MODEL_ORDER_LIST,omitempty"`
+}
+
+// GetModelsConfig gets the model selection settings
+func (c *Client) GetModelsConfig(ctx context.Context) (*ModelsConfig, error) {
+	var result ModelsConfig
+	if err := c.do(ctx, "GET", "/api/v1/configs/models", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateModelsConfig changes the model selection settings
+func (c *Client) UpdateModelsConfig(ctx context.Context, form *ModelsConfig) (*ModelsConfig, error) {
+	var result ModelsConfig
+	if err := c.update(ctx, "/api/v1/configs/models", "/api/v1/configs/models", form, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DefaultModelIDs returns the IDs of the default models in order
+func (m *ModelsConfig) DefaultModelIDs() []string {
+	ids := []string{}
+	if m.DefaultModels == nil {
+		return ids
+	}
+	for _, id := range strings.Split(*m.DefaultModels, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// ModelOrderIDs returns the IDs of the models listed first in the model selector
+func (m *ModelsConfig) ModelOrderIDs() []string {
+	if m.ModelOrderList == nil {
+		return []string{}
+	}
+	return *m.ModelOrderList
+}
@@ -0,0 +1,53 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package configs
+
+import "context"
+
+// OAuthConfig represents the persisted OAuth settings, found under "oauth" in
+// the exported configuration. Nil fields are left unchanged by UpdateOAuthConfig.
+type OAuthConfig struct {
+	EnableSignup         *bool       `json:"enable_signup,omitempty"`
+	MergeAccountsByEmail *bool       `json:"merge_accounts_by_email,omitempty"`
+	EnableGroupMapping   *bool       `json:"enable_group_mapping,omitempty"`
+	OIDC                 *OIDCConfig `json:"oidc,omitempty"`
+}
+
+// OIDCConfig represents the settings of the generic OpenID Connect provider
+type OIDCConfig struct {
+	ClientID     *string `json:"client_id,omitempty"`
+	ClientSecret *string `json:"client_secret,omitempty"`
+	ProviderURL  *string `json:"provider_url,omitempty"`
+	ProviderName *string `json:"provider_name,omitempty"`
+	Scopes       *string `json:"scopes,omitempty"`
+	GroupClaim   *string `json:"group_claim,omitempty"`
+}
+
+// GetOAuthConfig gets the persisted OAuth settings
+func (c *Client) GetOAuthConfig(ctx context.Context) (*OAuthConfig, error) {
+	config, err := c.Export(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result OAuthConfig
+	if err := convert(config["oauth"], &result); err != nil {
+		return nil, err
+	}
+	if result.OIDC == nil {
+		result.OIDC = &OIDCConfig{}
+	}
+	return &result, nil
+}
+
+// UpdateOAuthConfig changes the persisted OAuth settings. There is no
+// dedicated endpoint for them, so the full configuration is exported, changed
+// and imported again.
+func (c *Client) UpdateOAuthConfig(ctx context.Context, form *OAuthConfig) (*OAuthConfig, error) {
+	if err := c.importSection(ctx, "oauth", form); err != nil {
+		return nil, err
+	}
+
+	return c.GetOAuthConfig(ctx)
+}
@@ -0,0 +1,81 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package configs
+
+import (
+	"context"
+	"slices"
+)
+
+// ollamaConfig represents the Ollama API connections. Unlike OpenAI
+// connections, the API key is part of the config of a connection.
+type ollamaConfig struct {
+	Enable   *bool                             `json:"ENABLE_OLLAMA_API,omitempty"`
+	BaseURLs []string                          `json:"OLLAMA_BASE_URLS"`
+	Configs  map[string]map[string]interface{} `json:"OLLAMA_API_CONFIGS"`
+}
+
+// GetOllamaConnection gets the Ollama API connection with the given URL
+func (c *Client) GetOllamaConnection(ctx context.Context, url string) (*Connection, error) {
+	var config ollamaConfig
+	if err := c.do(ctx, "GET", "/ollama/config", nil, &config); err != nil {
+		return nil, err
+	}
+
+	idx, err := connectionIndex(config.BaseURLs, url)
+	if err != nil {
+		return nil, err
+	}
+	return connectionFromConfig(config.BaseURLs[idx], nil, config.Configs, idx)
+}
+
+// SetOllamaConnection adds the Ollama API connection, or changes the
+// connection with the same URL
+func (c *Client) SetOllamaConnection(ctx context.Context, conn *Connection) (*Connection, error) {
+	c.connectionsMu.Lock()
+	defer c.connectionsMu.Unlock()
+
+	var config ollamaConfig
+	if err := c.do(ctx, "GET", "/ollama/config", nil, &config); err != nil {
+		return nil, err
+	}
+
+	idx, err := connectionIndex(config.BaseURLs, conn.URL)
+	if err != nil {
+		config.BaseURLs = append(config.BaseURLs, conn.URL)
+		idx = len(config.BaseURLs) - 1
+	}
+
+	config.Configs, err = setConnectionConfig(config.Configs, idx, conn, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.do(ctx, "POST", "/ollama/config/update", &config, nil); err != nil {
+		return nil, err
+	}
+	return c.GetOllamaConnection(ctx, conn.URL)
+}
+
+// DeleteOllamaConnection removes the Ollama API connection with the given
+// URL. Missing connections are ignored.
+func (c *Client) DeleteOllamaConnection(ctx context.Context, url string) error {
+	c.connectionsMu.Lock()
+	defer c.connectionsMu.Unlock()
+
+	var config ollamaConfig
+	if err := c.do(ctx, "GET", "/ollama/config", nil, &config); err != nil {
+		return err
+	}
+
+	idx, err := connectionIndex(config.BaseURLs, url)
+	if err != nil {
+		return nil
+	}
+
+	config.BaseURLs = slices.Delete(config.BaseURLs, idx, idx+1)
+	config.Configs = removeConnectionConfig(config.Configs, idx)
+
+	return c.do(ctx, "POST", "/ollama/config/update", &config, nil)
+}
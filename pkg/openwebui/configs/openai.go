@@ -0,0 +1,96 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package configs
+
+import (
+	"context"
+	"slices"
+)
+
+// openAIConfig represents the OpenAI compatible API connections. Keys holds
+// the API key of the connection at the same position in BaseURLs.
+type openAIConfig struct {
+	Enable   *bool                             `json:"ENABLE_OPENAI_API,omitempty"`
+	BaseURLs []string                          `json:"OPENAI_API_BASE_URLS"`
+	Keys     []string                          `json:"OPENAI_API_KEYS"`
+	Configs  map[string]map[string]interface{} `json:"OPENAI_API_CONFIGS"`
+}
+
+// GetOpenAIConnection gets the OpenAI compatible API connection with the given URL
+func (c *Client) GetOpenAIConnection(ctx context.Context, url string) (*Connection, error) {
+	var config openAIConfig
+	if err := c.do(ctx, "GET", "/openai/config", nil, &config); err != nil {
+		return nil, err
+	}
+
+	idx, err := connectionIndex(config.BaseURLs, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var key *string
+	if idx < len(config.Keys) {
+		key = &config.Keys[idx]
+	}
+	return connectionFromConfig(config.BaseURLs[idx], key, config.Configs, idx)
+}
+
+// SetOpenAIConnection adds the OpenAI compatible API connection, or changes
+// the connection with the same URL
+func (c *Client) SetOpenAIConnection(ctx context.Context, conn *Connection) (*Connection, error) {
+	c.connectionsMu.Lock()
+	defer c.connectionsMu.Unlock()
+
+	var config openAIConfig
+	if err := c.do(ctx, "GET", "/openai/config", nil, &config); err != nil {
+		return nil, err
+	}
+
+	idx, err := connectionIndex(config.BaseURLs, conn.URL)
+	if err != nil {
+		config.BaseURLs = append(config.BaseURLs, conn.URL)
+		idx = len(config.BaseURLs) - 1
+	}
+	for len(config.Keys) < len(config.BaseURLs) {
+		config.Keys = append(config.Keys, "")
+	}
+	if conn.Key != nil {
+		config.Keys[idx] = *conn.Key
+	}
+
+	config.Configs, err = setConnectionConfig(config.Configs, idx, conn, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.do(ctx, "POST", "/openai/config/update", &config, nil); err != nil {
+		return nil, err
+	}
+	return c.GetOpenAIConnection(ctx, conn.URL)
+}
+
+// DeleteOpenAIConnection removes the OpenAI compatible API connection with the
+// given URL. Missing connections are ignored.
+func (c *Client) DeleteOpenAIConnection(ctx context.Context, url string) error {
+	c.connectionsMu.Lock()
+	defer c.connectionsMu.Unlock()
+
+	var config openAIConfig
+	if err := c.do(ctx, "GET", "/openai/config", nil, &config); err != nil {
+		return err
+	}
+
+	idx, err := connectionIndex(config.BaseURLs, url)
+	if err != nil {
+		return nil
+	}
+
+	config.BaseURLs = slices.Delete(config.BaseURLs, idx, idx+1)
+	if idx < len(config.Keys) {
+		config.Keys = slices.Delete(config.Keys, idx, idx+1)
+	}
+	config.Configs = removeConnectionConfig(config.Configs, idx)
+
+	return c.do(ctx, "POST", "/openai/config/update", &config, nil)
+}
@@ -0,0 +1,79 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package configs
+
+import "context"
+
+// RAGConfig represents the retrieval settings. Nil fields are left unchanged
+// by UpdateRAGConfig.
+type RAGConfig struct {
+	Template           *string  `json:"RAG_TEMPLATE,omitempty"`
+	TopK               *int64   `json:"TOP_K,omitempty"`
+	TopKReranker       *int64   `json:"TOP_K_RERANKER,omitempty"`
+	RelevanceThreshold *float64 `json:"RELEVANCE_THRESHOLD,omitempty"`
+	EnableHybridSearch *bool    `json:"ENABLE_RAG_HYBRID_SEARCH,omitempty"`
+	RerankingModel     *string  `json:"RAG_RERANKING_MODEL,omitempty"`
+	FullContext        *bool    `json:"RAG_FULL_CONTEXT,omitempty"`
+	TextSplitter       *string  `json:"TEXT_SPLITTER,omitempty"`
+	ChunkSize          *int64   `json:"CHUNK_SIZE,omitempty"`
+	ChunkOverlap       *int64   `json:"CHUNK_OVERLAP,omitempty"`
+}
+
+// EmbeddingConfig represents the settings of the model used to embed
+// documents. Nil fields are left unchanged by UpdateEmbeddingConfig.
+type EmbeddingConfig struct {
+	Engine       *string                  `json:"embedding_engine,omitempty"`
+	Model        *string                  `json:"embedding_model,omitempty"`
+	BatchSize    *int64                   `json:"embedding_batch_size,omitempty"`
+	OpenAIConfig *EmbeddingEndpointConfig `json:"openai_config,omitempty"`
+	OllamaConfig *EmbeddingEndpointConfig `json:"ollama_config,omitempty"`
+}
+
+// EmbeddingEndpointConfig represents the connection used by an embedding engine
+type EmbeddingEndpointConfig struct {
+	URL *string `json:"url,omitempty"`
+	Key *string `json:"key,omitempty"`
+}
+
+// GetRAGConfig gets the retrieval settings
+func (c *Client) GetRAGConfig(ctx context.Context) (*RAGConfig, error) {
+	var result RAGConfig
+	if err := c.do(ctx, "GET", "/api/v1/retrieval/config", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateRAGConfig changes the retrieval settings
+func (c *Client) UpdateRAGConfig(ctx context.Context, form *RAGConfig) (*RAGConfig, error) {
+	var result RAGConfig
+	if err := c.update(ctx, "/api/v1/retrieval/config", "/api/v1/retrieval/config/update", form, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetEmbeddingConfig gets the settings of the embedding model
+func (c *Client) GetEmbeddingConfig(ctx context.Context) (*EmbeddingConfig, error) {
+	var result EmbeddingConfig
+	if err := c.do(ctx, "GET", "/api/v1/retrieval/embedding", nil, &result); err != nil {
+		return nil, err
+	}
+	if result.OpenAIConfig == nil {
+		result.OpenAIConfig = &EmbeddingEndpointConfig{}
+	}
+	if result.OllamaConfig == nil {
+		result.OllamaConfig = &EmbeddingEndpointConfig{}
+	}
+	return &result, nil
+}
+
+// UpdateEmbeddingConfig changes the settings of the embedding model. OpenWebUI
+// loads the new model before it responds, which can take a while.
+func (c *Client) UpdateEmbeddingConfig(ctx context.Context, form *EmbeddingConfig) (*EmbeddingConfig, error) {
+	if err := c.update(ctx, "/api/v1/retrieval/embedding", "/api/v1/retrieval/embedding/update", form, nil); err != nil {
+		return nil, err
+	}
+	return c.GetEmbeddingConfig(ctx)
+}
@@ -0,0 +1,40 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package configs
+
+import "context"
+
+// TaskConfig represents the settings of background tasks such as title
+// generation. Nil fields are left unchanged by UpdateTaskConfig.
+type TaskConfig struct {
+	TaskModel                      *string `json:"TASK_MODEL,omitempty"`
+	TaskModelExternal              *string `json:"TASK_MODEL_EXTERNAL,omitempty"`
+	EnableTitleGeneration          *bool   `json:"ENABLE_TITLE_GENERATION,omitempty"`
+	TitleGenerationPromptTemplate  *string `json:"TITLE_GENERATION_PROMPT_TEMPLATE,omitempty"`
+	EnableTagsGeneration           *bool   `json:"ENABLE_TAGS_GENERATION,omitempty"`
+	TagsGenerationPromptTemplate   *string `json:"TAGS_GENERATION_PROMPT_TEMPLATE,omitempty"`
+	EnableAutocompleteGeneration   *bool   `json:"ENABLE_AUTOCOMPLETE_GENERATION,omitempty"`
+	AutocompleteInputMaxLength     *int64  `json:"AUTOCOMPLETE_GENERATION_INPUT_MAX_LENGTH,omitempty"`
+	EnableSearchQueryGeneration    *bool   `json:"ENABLE_SEARCH_QUERY_GENERATION,omitempty"`
+	EnableRetrievalQueryGeneration *bool   `json:"ENABLE_RETRIEVAL_QUERY_GENERATION,omitempty"`
+	QueryGenerationPromptTemplate  *string `json:"QUERY_GENERATION_PROMPT_TEMPLATE,omitempty"`
+}
+
+// GetTaskConfig gets the task model and background task settings
+func (c *Client) GetTaskConfig(ctx context.Context) (*TaskConfig, error) {
+	var result TaskConfig
+	if err := c.do(ctx, "GET", "/api/v1/tasks/config", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateTaskConfig changes the task model and background task settings
+func (c *Client) UpdateTaskConfig(ctx context.Context, form *TaskConfig) (*TaskConfig, error) {
+	var result TaskConfig
+	if err := c.update(ctx, "/api/v1/tasks/config", "/api/v1/tasks/config/update", form, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,37 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package configs
+
+// ToolServersConfig represents the tool server connections configured on the instance
+type ToolServersConfig struct {
+	ToolServerConnections []ToolServerConnection `json:"TOOL_SERVER_CONNECTIONS"`
+}
+
+// ToolServerConnection represents a single OpenAPI tool server registration
+type ToolServerConnection struct {
+	URL      string                 `json:"url"`
+	Path     string                 `json:"path"`
+	AuthType string                 `json:"auth_type,omitempty"`
+	Key      string                 `json:"key,omitempty"`
+	Config   map[string]interface{} `json:"config,omitempty"`
+	Info     *ToolServerInfo        `json:"info,omitempty"`
+}
+
+// ToolServerInfo holds the optional display information of a tool server
+type ToolServerInfo struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// Enabled reports whether the connection is enabled. Connections without an
+// explicit flag are treated as enabled, matching the OpenWebUI admin panel.
+func (t *ToolServerConnection) Enabled() bool {
+	if t.Config == nil {
+		return true
+	}
+	if enable, ok := t.Config["enable"].(bool); ok {
+		return enable
+	}
+	return true
+}
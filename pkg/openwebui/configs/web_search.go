@@ -0,0 +1,108 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package configs
+
+import (
+	"context"
+	"fmt"
+)
+
+// WebSearchConfig represents the web search settings, found under "web" in the
+// retrieval settings. Nil fields are left unchanged by UpdateWebSearchConfig.
+type WebSearchConfig struct {
+	Enabled                     *bool     `json:"ENABLE_WEB_SEARCH,omitempty"`
+	Engine                      *string   `json:"WEB_SEARCH_ENGINE,omitempty"`
+	ResultCount                 *int64    `json:"WEB_SEARCH_RESULT_COUNT,omitempty"`
+	ConcurrentRequests          *int64    `json:"WEB_SEARCH_CONCURRENT_REQUESTS,omitempty"`
+	DomainFilterList            *[]string `json:"WEB_SEARCH_DOMAIN_FILTER_LIST,omitempty"`
+	TrustEnv                    *bool     `json:"WEB_SEARCH_TRUST_ENV,omitempty"`
+	BypassEmbeddingAndRetrieval *bool     `json:"BYPASS_WEB_SEARCH_EMBEDDING_AND_RETRIEVAL,omitempty"`
+	SearxngQueryURL             *string   `json:"SEARXNG_QUERY_URL,omitempty"`
+	GooglePSEEngineID           *string   `json:"GOOGLE_PSE_ENGINE_ID,omitempty"`
+
+	// APIKey is the API key of the selected engine. OpenWebUI stores a
+	// separate key per engine, see WebSearchAPIKeyFields.
+	APIKey *string `json:"-"`
+}
+
+// WebSearchAPIKeyFields maps the web search engines that need an API key to
+// the setting holding it
+var WebSearchAPIKeyFields = map[string]string{
+	"bing":       "BING_SEARCH_V7_SUBSCRIPTION_KEY",
+	"bocha":      "BOCHA_SEARCH_API_KEY",
+	"brave":      "BRAVE_SEARCH_API_KEY",
+	"exa":        "EXA_API_KEY",
+	"google_pse": "GOOGLE_PSE_API_KEY",
+	"jina":       "JINA_API_KEY",
+	"kagi":       "KAGI_SEARCH_API_KEY",
+	"mojeek":     "MOJEEK_SEARCH_API_KEY",
+	"perplexity": "PERPLEXITY_API_KEY",
+	"searchapi":  "SEARCHAPI_API_KEY",
+	"serpapi":    "SERPAPI_API_KEY",
+	"serper":     "SERPER_API_KEY",
+	"serply":     "SERPLY_API_KEY",
+	"serpstack":  "SERPSTACK_API_KEY",
+	"tavily":     "TAVILY_API_KEY",
+}
+
+// GetWebSearchConfig gets the web search settings
+func (c *Client) GetWebSearchConfig(ctx context.Context) (*WebSearchConfig, error) {
+	var current map[string]interface{}
+	if err := c.do(ctx, "GET", "/api/v1/retrieval/config", nil, &current); err != nil {
+		return nil, err
+	}
+	return webSearchConfigFromMap(current["web"])
+}
+
+// UpdateWebSearchConfig changes the web search settings. The API key is stored
+// for the engine in form, or the current engine when form does not set one.
+func (c *Client) UpdateWebSearchConfig(ctx context.Context, form *WebSearchConfig) (*WebSearchConfig, error) {
+	mu := c.endpointMu("/api/v1/retrieval/config")
+	mu.Lock()
+	defer mu.Unlock()
+
+	var current map[string]interface{}
+	if err := c.do(ctx, "GET", "/api/v1/retrieval/config", nil, &current); err != nil {
+		return nil, err
+	}
+
+	changes, err := toMap(form)
+	if err != nil {
+		return nil, err
+	}
+
+	if form.APIKey != nil {
+		web, _ := current["web"].(map[string]interface{})
+		engine, _ := web["WEB_SEARCH_ENGINE"].(string)
+		if form.Engine != nil {
+			engine = *form.Engine
+		}
+		field, ok := WebSearchAPIKeyFields[engine]
+		if !ok {
+			return nil, fmt.Errorf("web search engine %q does not use an API key", engine)
+		}
+		changes[field] = *form.APIKey
+	}
+
+	merged := merge(current, map[string]interface{}{"web": changes})
+	if err := c.do(ctx, "POST", "/api/v1/retrieval/config/update", merged, nil); err != nil {
+		return nil, err
+	}
+	return c.GetWebSearchConfig(ctx)
+}
+
+// webSearchConfigFromMap decodes the "web" section of the retrieval settings
+func webSearchConfigFromMap(web interface{}) (*WebSearchConfig, error) {
+	var result WebSearchConfig
+	if err := convert(web, &result); err != nil {
+		return nil, err
+	}
+
+	if fields, ok := web.(map[string]interface{}); ok && result.Engine != nil {
+		if key, ok := fields[WebSearchAPIKeyFields[*result.Engine]].(string); ok {
+			result.APIKey = &key
+		}
+	}
+	return &result, nil
+}
@@ -0,0 +1,30 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package configs
+
+import "context"
+
+// webhookForm is the request and response body of the webhook endpoint
+type webhookForm struct {
+	URL string `json:"url"`
+}
+
+// GetWebhookURL gets the URL notified about events such as new signups
+func (c *Client) GetWebhookURL(ctx context.Context) (string, error) {
+	var result webhookForm
+	if err := c.do(ctx, "GET", "/api/webhook", nil, &result); err != nil {
+		return "", err
+	}
+	return result.URL, nil
+}
+
+// SetWebhookURL changes the URL notified about events such as new signups.
+// An empty URL disables the notifications.
+func (c *Client) SetWebhookURL(ctx context.Context, url string) (string, error) {
+	var result webhookForm
+	if err := c.do(ctx, "POST", "/api/webhook", &webhookForm{URL: url}, &result); err != nil {
+		return "", err
+	}
+	return result.URL, nil
+}
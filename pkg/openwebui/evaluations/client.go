@@ -0,0 +1,30 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package evaluations
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/internal/rest"
+)
+
+// Client implements the evaluations operations
+type Client struct {
+	api *rest.Client
+}
+
+// NewClient creates a new evaluations client
+func NewClient(endpoint, token string, httpClient *http.Client) *Client {
+	return &Client{api: rest.New(endpoint, token, httpClient)}
+}
+
+// ListFeedbacks gets all feedback records. Requires an admin token.
+func (c *Client) ListFeedbacks(ctx context.Context) ([]Feedback, error) {
+	var result []Feedback
+	if err := c.api.Do(ctx, "GET", "/api/v1/evaluations/feedbacks/all", nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
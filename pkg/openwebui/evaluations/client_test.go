@@ -0,0 +1,50 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package evaluations
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+)
+
+func TestListFeedbacks(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/evaluations/feedbacks/all" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`[{"id":"f1","user_id":"u1","type":"rating","data":{"rating":1,"model_id":"llama3"}},{"id":"f2","user_id":"u1","type":"rating","data":{"rating":"-1","model_id":"llama3"}}]`))
+	}))
+	defer ts.Close()
+
+	feedbacks, err := NewClient(ts.URL, "token", ts.Client()).ListFeedbacks(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(feedbacks) != 2 || feedbacks[0].Data == nil || feedbacks[0].Data.ModelID != "llama3" {
+		t.Fatalf("feedbacks = %+v", feedbacks)
+	}
+	// Older clients submitted the rating as a string
+	if feedbacks[1].Data.Rating != "-1" {
+		t.Errorf("rating = %v, want the string -1", feedbacks[1].Data.Rating)
+	}
+}
+
+func TestListFeedbacksForbidden(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"detail":"You do not have permission to access this resource."}`))
+	}))
+	defer ts.Close()
+
+	_, err := NewClient(ts.URL, "token", ts.Client()).ListFeedbacks(context.Background())
+	if !errors.Is(err, apierror.ErrForbidden) {
+		t.Errorf("error = %v, want ErrForbidden", err)
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package evaluations
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	// initialRating is the Elo rating every model starts with
+	initialRating = 1000
+	// kFactor is the maximum rating change of a single comparison
+	kFactor = 32
+)
+
+// Leaderboard computes the arena leaderboard from the given feedback records.
+// It mirrors the Elo calculation performed by the OpenWebUI admin panel so the
+// ratings match what administrators see in the UI. Entries are sorted by
+// rating in descending order.
+func Leaderboard(feedbacks []Feedback) []LeaderboardEntry {
+	stats := make(map[string]*LeaderboardEntry)
+	get := func(modelID string) *LeaderboardEntry {
+		entry, ok := stats[modelID]
+		if !ok {
+			entry = &LeaderboardEntry{ModelID: modelID, Rating: initialRating}
+			stats[modelID] = entry
+		}
+		return entry
+	}
+
+	for _, feedback := range feedbacks {
+		if feedback.Data == nil || feedback.Data.ModelID == "" {
+			continue
+		}
+
+		var outcome float64
+		switch fmt.Sprint(feedback.Data.Rating) {
+		case "1":
+			outcome = 1
+		case "-1":
+			outcome = 0
+		default:
+			continue
+		}
+
+		modelA := get(feedback.Data.ModelID)
+		for _, opponent := range feedback.Data.SiblingModelIDs {
+			modelB := get(opponent)
+			changeA := eloChange(modelA.Rating, modelB.Rating, outcome)
+			changeB := eloChange(modelB.Rating, modelA.Rating, 1-outcome)
+			modelA.Rating += changeA
+			modelB.Rating += changeB
+			if outcome == 1 {
+				modelA.Won++
+				modelB.Lost++
+			} else {
+				modelA.Lost++
+				modelB.Won++
+			}
+		}
+	}
+
+	entries := make([]LeaderboardEntry, 0, len(stats))
+	for _, entry := range stats {
+		entries = append(entries, *entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Rating != entries[j].Rating {
+			return entries[i].Rating > entries[j].Rating
+		}
+		return entries[i].ModelID < entries[j].ModelID
+	})
+
+	return entries
+}
+
+func eloChange(ratingA, ratingB, outcome float64) float64 {
+	expected := 1 / (1 + math.Pow(10, (ratingB-ratingA)/400))
+	return kFactor * (outcome - expected)
+}
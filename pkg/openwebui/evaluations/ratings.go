@@ -0,0 +1,53 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package evaluations
+
+import (
+	"fmt"
+	"sort"
+)
+
+// RatingCounts counts the thumbs up and thumbs down ratings of every model in
+// the given feedback records. Unlike the leaderboard, ratings count for the
+// rated model only, whether or not it was compared against other models.
+// Entries are sorted by model ID.
+func RatingCounts(feedbacks []Feedback) []ModelRatings {
+	stats := make(map[string]*ModelRatings)
+	for _, feedback := range feedbacks {
+		if feedback.Data == nil || feedback.Data.ModelID == "" {
+			continue
+		}
+
+		entry, ok := stats[feedback.Data.ModelID]
+		if !ok {
+			entry = &ModelRatings{ModelID: feedback.Data.ModelID}
+			stats[feedback.Data.ModelID] = entry
+		}
+
+		switch fmt.Sprint(feedback.Data.Rating) {
+		case "1":
+			entry.Positive++
+		case "-1":
+			entry.Negative++
+		default:
+			continue
+		}
+		if feedback.UpdatedAt > entry.LastRatedAt {
+			entry.LastRatedAt = feedback.UpdatedAt
+		}
+	}
+
+	entries := make([]ModelRatings, 0, len(stats))
+	for _, entry := range stats {
+		if entry.Positive+entry.Negative == 0 {
+			continue
+		}
+		entries = append(entries, *entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ModelID < entries[j].ModelID
+	})
+
+	return entries
+}
@@ -0,0 +1,42 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package evaluations
+
+// Feedback represents the API response for a feedback record
+type Feedback struct {
+	ID        string                 `json:"id"`
+	UserID    string                 `json:"user_id"`
+	Version   int64                  `json:"version"`
+	Type      string                 `json:"type"`
+	Data      *FeedbackData          `json:"data,omitempty"`
+	Meta      map[string]interface{} `json:"meta,omitempty"`
+	CreatedAt int64                  `json:"created_at"`
+	UpdatedAt int64                  `json:"updated_at"`
+}
+
+// FeedbackData holds the rating payload of a feedback record.
+// Rating is left untyped because older clients submitted it as a string.
+type FeedbackData struct {
+	Rating          interface{} `json:"rating,omitempty"`
+	ModelID         string      `json:"model_id"`
+	SiblingModelIDs []string    `json:"sibling_model_ids,omitempty"`
+	Reason          string      `json:"reason,omitempty"`
+	Comment         string      `json:"comment,omitempty"`
+}
+
+// LeaderboardEntry represents the aggregated arena statistics of a model
+type LeaderboardEntry struct {
+	ModelID string
+	Rating  float64
+	Won     int64
+	Lost    int64
+}
+
+// ModelRatings represents the rating counts of a model
+type ModelRatings struct {
+	ModelID     string
+	Positive    int64
+	Negative    int64
+	LastRatedAt int64
+}
@@ -0,0 +1,123 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package files
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/internal/rest"
+)
+
+// Client implements the files operations
+type Client struct {
+	// endpoint, token and httpClient send the multipart uploads, which the
+	// JSON client does not handle
+	endpoint   string
+	token      string
+	httpClient *http.Client
+	api        *rest.Client
+}
+
+// NewClient creates a new files client
+func NewClient(endpoint, token string, httpClient *http.Client) *Client {
+	return &Client{
+		endpoint:   endpoint,
+		token:      token,
+		httpClient: httpClient,
+		api:        rest.New(endpoint, token, httpClient),
+	}
+}
+
+// List gets all files visible to the authenticated user
+func (c *Client) List(ctx context.Context) ([]File, error) {
+	var result []File
+	if err := c.api.Do(ctx, "GET", "/api/v1/files/", nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Get gets a file by ID
+func (c *Client) Get(ctx context.Context, id string) (*File, error) {
+	var result File
+	if err := c.api.Do(ctx, "GET", fmt.Sprintf("/api/v1/files/%s", id), nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// FindByHash finds a file by its content hash
+func (c *Client) FindByHash(ctx context.Context, hash string) (*File, error) {
+	files, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		if file.Hash != nil && *file.Hash == hash {
+			return &file, nil
+		}
+	}
+
+	return nil, fmt.Errorf("file not found with hash: %s", hash)
+}
+
+// Upload uploads the content as a new file with the given name
+func (c *Client) Upload(ctx context.Context, filename string, content io.Reader) (*File, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		return nil, fmt.Errorf("error creating form file: %v", err)
+	}
+	if _, err := io.Copy(part, content); err != nil {
+		return nil, fmt.Errorf("error writing form file: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("error closing multipart writer: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/v1/files/", c.endpoint), body)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, apierror.FromResponse(resp)
+	}
+
+	var result File
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("error decoding response: %v", err)
+	}
+
+	return &result, nil
+}
+
+// Delete deletes a file. A file that no longer exists is not an error.
+func (c *Client) Delete(ctx context.Context, id string) error {
+	err := c.api.Do(ctx, "DELETE", "/api/v1/files/"+id, nil, nil)
+	if err != nil && !errors.Is(err, apierror.ErrNotFound) {
+		return err
+	}
+	return nil
+}
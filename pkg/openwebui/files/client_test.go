@@ -0,0 +1,80 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package files
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+)
+
+func TestUploadAndFindByHash(t *testing.T) {
+	var filename, content string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/v1/files/":
+			file, header, err := r.FormFile("file")
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			b, _ := io.ReadAll(file)
+			filename, content = header.Filename, string(b)
+			_, _ = w.Write([]byte(`{"id":"f1","filename":"notes.txt","hash":"abc","data":{"status":"completed"}}`))
+		case r.Method == "GET" && r.URL.Path == "/api/v1/files/":
+			_, _ = w.Write([]byte(`[{"id":"f0","filename":"other.txt","hash":"def"},{"id":"f1","filename":"notes.txt","hash":"abc"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(ts.URL, "token", ts.Client())
+	ctx := context.Background()
+
+	file, err := client.Upload(ctx, "notes.txt", strings.NewReader("hello"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if filename != "notes.txt" || content != "hello" {
+		t.Errorf("uploaded filename=%q content=%q", filename, content)
+	}
+	if file.ID != "f1" || file.ProcessingStatus() != "completed" {
+		t.Errorf("file = %+v", file)
+	}
+
+	found, err := client.FindByHash(ctx, "abc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if found.ID != "f1" {
+		t.Errorf("ID = %q, want f1", found.ID)
+	}
+}
+
+func TestDeleteMissingFile(t *testing.T) {
+	status, detail := http.StatusNotFound, "We could not find what you're looking for :/"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(map[string]string{"detail": detail})
+	}))
+	defer ts.Close()
+
+	client := NewClient(ts.URL, "token", ts.Client())
+	if err := client.Delete(context.Background(), "missing"); err != nil {
+		t.Errorf("unexpected error for a missing file: %v", err)
+	}
+
+	status, detail = http.StatusForbidden, "You do not have permission to access this resource."
+	if err := client.Delete(context.Background(), "f1"); !errors.Is(err, apierror.ErrForbidden) {
+		t.Errorf("error = %v, want ErrForbidden", err)
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package files
+
+// File represents the API response for an uploaded file
+type File struct {
+	ID        string                 `json:"id"`
+	UserID    string                 `json:"user_id"`
+	Hash      *string                `json:"hash,omitempty"`
+	Filename  string                 `json:"filename"`
+	Path      *string                `json:"path,omitempty"`
+	Data      map[string]interface{} `json:"data,omitempty"`
+	Meta      *FileMeta              `json:"meta,omitempty"`
+	CreatedAt int64                  `json:"created_at"`
+	UpdatedAt int64                  `json:"updated_at"`
+}
+
+// FileMeta holds the metadata recorded for an uploaded file
+type FileMeta struct {
+	Name        *string `json:"name,omitempty"`
+	ContentType *string `json:"content_type,omitempty"`
+	Size        *int64  `json:"size,omitempty"`
+}
+
+// ProcessingStatus returns the processing status reported in the file data, if any
+func (f *File) ProcessingStatus() string {
+	if f.Data == nil {
+		return ""
+	}
+	if status, ok := f.Data["status"].(string); ok {
+		return status
+	}
+	return ""
+}
+
+// ProcessingError returns the processing error reported in the file data, if any
+func (f *File) ProcessingError() string {
+	if f.Data == nil {
+		return ""
+	}
+	if msg, ok := f.Data["error"].(string); ok {
+		return msg
+	}
+	return ""
+}
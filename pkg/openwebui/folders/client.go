@@ -0,0 +1,64 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package folders
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/internal/rest"
+)
+
+// Client implements the folders operations
+type Client struct {
+	api *rest.Client
+}
+
+// NewClient creates a new folders client
+func NewClient(endpoint, token string, httpClient *http.Client) *Client {
+	return &Client{api: rest.New(endpoint, token, httpClient)}
+}
+
+// List gets all folders owned by the authenticated user
+func (c *Client) List(ctx context.Context) ([]Folder, error) {
+	var result []Folder
+	if err := c.api.Do(ctx, "GET", "/api/v1/folders/", nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Get gets a folder by ID
+func (c *Client) Get(ctx context.Context, id string) (*Folder, error) {
+	var result Folder
+	if err := c.api.Do(ctx, "GET", fmt.Sprintf("/api/v1/folders/%s", id), nil, &result); err != nil {
+		return nil, fmt.Errorf("folder %s: %w", id, err)
+	}
+	return &result, nil
+}
+
+// Create creates a new top level folder
+func (c *Client) Create(ctx context.Context, form *FolderForm) (*Folder, error) {
+	var result Folder
+	if err := c.api.Do(ctx, "POST", "/api/v1/folders/", form, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Update updates the name and data of a folder
+func (c *Client) Update(ctx context.Context, id string, form *FolderForm) error {
+	return c.api.Do(ctx, "POST", fmt.Sprintf("/api/v1/folders/%s/update", id), form, nil)
+}
+
+// UpdateParent moves a folder below another folder, or to the top level if parentID is nil
+func (c *Client) UpdateParent(ctx context.Context, id string, parentID *string) error {
+	return c.api.Do(ctx, "POST", fmt.Sprintf("/api/v1/folders/%s/update/parent", id), &FolderParentForm{ParentID: parentID}, nil)
+}
+
+// Delete deletes a folder
+func (c *Client) Delete(ctx context.Context, id string) error {
+	return c.api.Do(ctx, "DELETE", fmt.Sprintf("/api/v1/folders/%s", id), nil, nil)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package folders
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+)
+
+func TestUpdateParentSendsNullForTopLevel(t *testing.T) {
+	var sent map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v1/folders/f1/update/parent" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&sent)
+		_, _ = w.Write([]byte(`{"id":"f1","name":"Work"}`))
+	}))
+	defer ts.Close()
+
+	if err := NewClient(ts.URL, "token", ts.Client()).UpdateParent(context.Background(), "f1", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if parent, ok := sent["parent_id"]; !ok || parent != nil {
+		t.Errorf("parent_id = %v, want null to move the folder to the top level", sent["parent_id"])
+	}
+}
+
+func TestCreate(t *testing.T) {
+	var form FolderForm
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v1/folders/" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&form)
+		_, _ = w.Write([]byte(`{"id":"f1","user_id":"u1","name":"Work","data":{"system_prompt":null,"model_ids":["llama3"]}}`))
+	}))
+	defer ts.Close()
+
+	folder, err := NewClient(ts.URL, "token", ts.Client()).Create(context.Background(), &FolderForm{Name: "Work", Data: &FolderData{ModelIDs: []string{"llama3"}}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if form.Name != "Work" || form.Data == nil || len(form.Data.ModelIDs) != 1 {
+		t.Errorf("sent form %+v", form)
+	}
+	if folder.ID != "f1" || folder.Data == nil || folder.Data.ModelIDs[0] != "llama3" {
+		t.Errorf("folder = %+v", folder)
+	}
+}
+
+func TestGetNotFound(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"detail":"We could not find what you're looking for :/"}`))
+	}))
+	defer ts.Close()
+
+	_, err := NewClient(ts.URL, "token", ts.Client()).Get(context.Background(), "missing")
+	if !errors.Is(err, apierror.ErrNotFound) {
+		t.Errorf("error = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package folders
+
+// Folder represents the API response for a folder
+type Folder struct {
+	ID         string                 `json:"id"`
+	ParentID   *string                `json:"parent_id,omitempty"`
+	UserID     string                 `json:"user_id"`
+	Name       string                 `json:"name"`
+	Items      map[string]interface{} `json:"items,omitempty"`
+	Data       *FolderData            `json:"data,omitempty"`
+	Meta       map[string]interface{} `json:"meta,omitempty"`
+	IsExpanded bool                   `json:"is_expanded"`
+	CreatedAt  int64                  `json:"created_at"`
+	UpdatedAt  int64                  `json:"updated_at"`
+}
+
+// FolderData holds the chat defaults of a folder
+type FolderData struct {
+	// SystemPrompt is sent as null to remove it, since the server merges data on update
+	SystemPrompt *string  `json:"system_prompt"`
+	ModelIDs     []string `json:"model_ids"`
+}
+
+// FolderForm represents the form data for creating/updating a folder
+type FolderForm struct {
+	Name string      `json:"name"`
+	Data *FolderData `json:"data,omitempty"`
+}
+
+// FolderParentForm represents the form data for moving a folder
+type FolderParentForm struct {
+	// ParentID is sent as null to move the folder to the top level
+	ParentID *string `json:"parent_id"`
+}
@@ -0,0 +1,101 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package functions
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/internal/rest"
+)
+
+// Client implements the function operations
+type Client struct {
+	api *rest.Client
+}
+
+// NewClient creates a new functions client
+func NewClient(endpoint, token string, httpClient *http.Client) *Client {
+	return &Client{api: rest.New(endpoint, token, httpClient)}
+}
+
+// List gets all functions installed on the instance
+func (c *Client) List(ctx context.Context) ([]Function, error) {
+	var result []Function
+	if err := c.api.Do(ctx, "GET", "/api/v1/functions/", nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Get gets a function by ID
+func (c *Client) Get(ctx context.Context, id string) (*Function, error) {
+	var result Function
+	if err := c.api.Do(ctx, "GET", fmt.Sprintf("/api/v1/functions/id/%s", url.PathEscape(id)), nil, &result); err != nil {
+		return nil, fmt.Errorf("function %s: %w", id, err)
+	}
+	return &result, nil
+}
+
+// ToggleActive flips whether the function is active and returns the changed function
+func (c *Client) ToggleActive(ctx context.Context, id string) (*Function, error) {
+	var result Function
+	if err := c.api.Do(ctx, "POST", fmt.Sprintf("/api/v1/functions/id/%s/toggle", url.PathEscape(id)), nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ToggleGlobal flips whether the function applies to all models and returns
+// the changed function
+func (c *Client) ToggleGlobal(ctx context.Context, id string) (*Function, error) {
+	var result Function
+	if err := c.api.Do(ctx, "POST", fmt.Sprintf("/api/v1/functions/id/%s/toggle/global", url.PathEscape(id)), nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetValves gets the valve values of a function. The result is nil when the
+// valves were never set.
+func (c *Client) GetValves(ctx context.Context, id string) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	if err := c.api.Do(ctx, "GET", fmt.Sprintf("/api/v1/functions/id/%s/valves", url.PathEscape(id)), nil, &result); err != nil {
+		return nil, fmt.Errorf("function %s: %w", id, err)
+	}
+	return result, nil
+}
+
+// GetValvesSpec gets the JSON schema of the valves of a function. The result
+// is nil when the function has no valves.
+func (c *Client) GetValvesSpec(ctx context.Context, id string) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	if err := c.api.Do(ctx, "GET", fmt.Sprintf("/api/v1/functions/id/%s/valves/spec", url.PathEscape(id)), nil, &result); err != nil {
+		return nil, fmt.Errorf("function %s: %w", id, err)
+	}
+	return result, nil
+}
+
+// UpdateValves changes the valve values of a function. Valves that are not
+// in valves keep their current value.
+func (c *Client) UpdateValves(ctx context.Context, id string, valves map[string]interface{}) (map[string]interface{}, error) {
+	current, err := c.GetValves(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		current = make(map[string]interface{}, len(valves))
+	}
+	for key, value := range valves {
+		current[key] = value
+	}
+
+	var result map[string]interface{}
+	if err := c.api.Do(ctx, "POST", fmt.Sprintf("/api/v1/functions/id/%s/valves/update", url.PathEscape(id)), current, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
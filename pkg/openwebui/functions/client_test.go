@@ -0,0 +1,79 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package functions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+)
+
+func TestUpdateValvesKeepsOtherValves(t *testing.T) {
+	// The server returns null for valves that were never set
+	var stored map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/functions/id/filter/valves":
+		case "/api/v1/functions/id/filter/valves/update":
+			_ = json.NewDecoder(r.Body).Decode(&stored)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(stored)
+	}))
+	defer ts.Close()
+
+	client := NewClient(ts.URL, "token", ts.Client())
+	ctx := context.Background()
+
+	if _, err := client.UpdateValves(ctx, "filter", map[string]interface{}{"priority": 1}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	valves, err := client.UpdateValves(ctx, "filter", map[string]interface{}{"enabled": true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if valves["priority"] != 1.0 || valves["enabled"] != true {
+		t.Errorf("valves = %v, want priority kept and enabled set", valves)
+	}
+}
+
+func TestToggleActive(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v1/functions/id/filter/toggle" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"filter","type":"filter","name":"Filter","meta":{"description":null},"is_active":true}`))
+	}))
+	defer ts.Close()
+
+	function, err := NewClient(ts.URL, "token", ts.Client()).ToggleActive(context.Background(), "filter")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !function.IsActive || function.Type != "filter" {
+		t.Errorf("function = %+v", function)
+	}
+}
+
+func TestGetNotFound(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"detail":"We could not find what you're looking for :/"}`))
+	}))
+	defer ts.Close()
+
+	_, err := NewClient(ts.URL, "token", ts.Client()).Get(context.Background(), "missing")
+	if !errors.Is(err, apierror.ErrNotFound) {
+		t.Errorf("error = %v, want ErrNotFound", err)
+	}
+}
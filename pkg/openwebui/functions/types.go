@@ -0,0 +1,23 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package functions
+
+// Function represents a function (pipe, filter or action) installed on the instance
+type Function struct {
+	ID        string       `json:"id"`
+	UserID    string       `json:"user_id"`
+	Type      string       `json:"type"`
+	Name      string       `json:"name"`
+	Meta      FunctionMeta `json:"meta"`
+	IsActive  bool         `json:"is_active"`
+	IsGlobal  bool         `json:"is_global"`
+	UpdatedAt int64        `json:"updated_at"`
+	CreatedAt int64        `json:"created_at"`
+}
+
+// FunctionMeta represents the metadata of a function
+type FunctionMeta struct {
+	Description *string                `json:"description"`
+	Manifest    map[string]interface{} `json:"manifest,omitempty"`
+}
@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+
+package groups
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+)
+
+type Client struct {
+	BaseURL    string
+	Token      string
+	HTTPClient *http.Client
+}
+
+func NewClient(baseURL string, token string, httpClient *http.Client) *Client {
+	return &Client{
+		BaseURL:    baseURL,
+		Token:      token,
+		HTTPClient: httpClient,
+	}
+}
+
+func (c *Client) Create(ctx context.Context, group *Group) (*Group, error) {
+	body, err := json.Marshal(group)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/v1/groups/create", c.BaseURL), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("accept", "application/json")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, apierror.FromResponse(resp)
+	}
+
+	var createdGroup Group
+	if err := json.NewDecoder(resp.Body).Decode(&createdGroup); err != nil {
+		return nil, err
+	}
+
+	return &createdGroup, nil
+}
+
+func (c *Client) Get(ctx context.Context, id string) (*Group, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v1/groups/id/%s", c.BaseURL, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
+	req.Header.Set("accept", "application/json")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("group %s: %w", id, apierror.New(resp, body))
+	}
+
+	var group Group
+	if err := json.NewDecoder(resp.Body).Decode(&group); err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+func (c *Client) Update(ctx context.Context, id string, group *Group) (*Group, error) {
+	body, err := json.Marshal(group)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/v1/groups/id/%s/update", c.BaseURL, id), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("accept", "application/json")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, apierror.FromResponse(resp)
+	}
+
+	var updatedGroup Group
+	if err := json.NewDecoder(resp.Body).Decode(&updatedGroup); err != nil {
+		return nil, err
+	}
+
+	return &updatedGroup, nil
+}
+
+// UpdateUserIDs replaces the members of a group. The rest of the group is sent
+// back exactly as the server returned it, so fields this client does not model
+// (e.g. additional permissions) are preserved.
+func (c *Client) UpdateUserIDs(ctx context.Context, id string, userIDs []string) (*Group, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v1/groups/id/%s", c.BaseURL, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
+	req.Header.Set("accept", "application/json")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, apierror.FromResponse(resp)
+	}
+
+	var current map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&current); err != nil {
+		return nil, err
+	}
+
+	current["user_ids"] = userIDs
+
+	body, err := json.Marshal(current)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err = http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/v1/groups/id/%s/update", c.BaseURL, id), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("accept", "application/json")
+
+	updateResp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer updateResp.Body.Close()
+
+	if updateResp.StatusCode != http.StatusOK {
+		return nil, apierror.FromResponse(updateResp)
+	}
+
+	var updatedGroup Group
+	if err := json.NewDecoder(updateResp.Body).Decode(&updatedGroup); err != nil {
+		return nil, err
+	}
+
+	return &updatedGroup, nil
+}
+
+func (c *Client) Delete(ctx context.Context, id string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/api/v1/groups/id/%s/delete", c.BaseURL, id), nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
+	req.Header.Set("accept", "application/json")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return apierror.FromResponse(resp)
+	}
+
+	return nil
+}
+
+func (c *Client) List(ctx context.Context) ([]Group, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v1/groups/", c.BaseURL), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
+	req.Header.Set("accept", "application/json")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, apierror.FromResponse(resp)
+	}
+
+	var groups []Group
+	if err := json.NewDecoder(resp.Body).Decode(&groups); err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
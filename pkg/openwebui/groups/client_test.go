@@ -0,0 +1,61 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package groups
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+)
+
+func TestUpdateUserIDsPreservesUnmodeledFields(t *testing.T) {
+	var sent map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/groups/id/g1":
+			_, _ = w.Write([]byte(`{"id":"g1","name":"team","user_ids":["u1"],"permissions":{"features":{"web_search":true}}}`))
+		case "/api/v1/groups/id/g1/update":
+			_ = json.NewDecoder(r.Body).Decode(&sent)
+			_, _ = w.Write([]byte(`{"id":"g1","name":"team","user_ids":["u2","u3"]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	group, err := NewClient(ts.URL, "token", ts.Client()).UpdateUserIDs(context.Background(), "g1", []string{"u2", "u3"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(group.UserIDs, []string{"u2", "u3"}) {
+		t.Errorf("UserIDs = %v, want [u2 u3]", group.UserIDs)
+	}
+	if !reflect.DeepEqual(sent["user_ids"], []interface{}{"u2", "u3"}) {
+		t.Errorf("sent user_ids = %v, want [u2 u3]", sent["user_ids"])
+	}
+	features, _ := sent["permissions"].(map[string]interface{})["features"].(map[string]interface{})
+	if features["web_search"] != true {
+		t.Errorf("unmodeled permissions were not sent back: %v", sent["permissions"])
+	}
+}
+
+func TestGetNotFound(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"detail":"We could not find what you're looking for :/"}`))
+	}))
+	defer ts.Close()
+
+	_, err := NewClient(ts.URL, "token", ts.Client()).Get(context.Background(), "missing")
+	if !errors.Is(err, apierror.ErrNotFound) {
+		t.Errorf("error = %v, want ErrNotFound", err)
+	}
+}
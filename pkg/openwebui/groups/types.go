@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+
+package groups
+
+type Group struct {
+	ID          string                 `json:"id"`
+	UserID      string                 `json:"user_id"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Permissions *GroupPermissions      `json:"permissions,omitempty"`
+	Data        map[string]interface{} `json:"data"`
+	Meta        map[string]interface{} `json:"meta"`
+	UserIDs     []string               `json:"user_ids"`
+	CreatedAt   int64                  `json:"created_at"`
+	UpdatedAt   int64                  `json:"updated_at"`
+}
+
+type GroupPermissions struct {
+	Workspace WorkspacePermissions `json:"workspace"`
+	Chat      ChatPermissions      `json:"chat"`
+	Sharing   *SharingPermissions  `json:"sharing,omitempty"`
+	Features  *FeaturePermissions  `json:"features,omitempty"`
+}
+
+type WorkspacePermissions struct {
+	Models    bool `json:"models"`
+	Knowledge bool `json:"knowledge"`
+	Prompts   bool `json:"prompts"`
+	Tools     bool `json:"tools"`
+}
+
+// ChatPermissions controls chat features. Optional permissions that are not
+// set fall back to the instance-wide default user permissions.
+type ChatPermissions struct {
+	FileUpload        bool  `json:"file_upload"`
+	Delete            bool  `json:"delete"`
+	Edit              bool  `json:"edit"`
+	Temporary         bool  `json:"temporary"`
+	Controls          *bool `json:"controls,omitempty"`
+	STT               *bool `json:"stt,omitempty"`
+	TTS               *bool `json:"tts,omitempty"`
+	Call              *bool `json:"call,omitempty"`
+	MultipleModels    *bool `json:"multiple_models,omitempty"`
+	TemporaryEnforced *bool `json:"temporary_enforced,omitempty"`
+}
+
+// SharingPermissions controls whether members can share workspace items publicly
+type SharingPermissions struct {
+	PublicModels    *bool `json:"public_models,omitempty"`
+	PublicKnowledge *bool `json:"public_knowledge,omitempty"`
+	PublicPrompts   *bool `json:"public_prompts,omitempty"`
+	PublicTools     *bool `json:"public_tools,omitempty"`
+}
+
+// FeaturePermissions controls access to optional instance features
+type FeaturePermissions struct {
+	DirectToolServers *bool `json:"direct_tool_servers,omitempty"`
+	WebSearch         *bool `json:"web_search,omitempty"`
+	ImageGeneration   *bool `json:"image_generation,omitempty"`
+	CodeInterpreter   *bool `json:"code_interpreter,omitempty"`
+}
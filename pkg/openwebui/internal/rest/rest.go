@@ -0,0 +1,80 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+// Package rest sends the JSON requests shared by the OpenWebUI client packages.
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+)
+
+// Client sends JSON requests to an OpenWebUI server
+type Client struct {
+	endpoint   string
+	token      string
+	httpClient *http.Client
+}
+
+// New creates a client that authenticates with token, unless it is empty
+func New(endpoint, token string, httpClient *http.Client) *Client {
+	return &Client{
+		endpoint:   endpoint,
+		token:      token,
+		httpClient: httpClient,
+	}
+}
+
+// Do sends a request to path with in as JSON body, unless it is nil, and
+// decodes the response into out, unless it is nil. Responses with another
+// status than 200 are returned as *apierror.APIError.
+func (c *Client) Do(ctx context.Context, method, path string, in interface{}, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("error encoding request: %v", err)
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.endpoint+path, body)
+	if err != nil {
+		return fmt.Errorf("error creating request: %v", err)
+	}
+
+	if c.token != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if out != nil {
+		req.Header.Set("Accept", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return apierror.FromResponse(resp)
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("error decoding response: %v", err)
+	}
+
+	return nil
+}
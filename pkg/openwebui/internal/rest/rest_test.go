@@ -0,0 +1,95 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+)
+
+func TestDoRoundTrip(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v1/things/create" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer token" || r.Header.Get("Content-Type") != "application/json" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var form map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&form)
+		_ = json.NewEncoder(w).Encode(map[string]string{"id": "t1", "name": form["name"]})
+	}))
+	defer ts.Close()
+
+	var result struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	err := New(ts.URL, "token", ts.Client()).Do(context.Background(), "POST", "/api/v1/things/create", map[string]string{"name": "thing"}, &result)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ID != "t1" || result.Name != "thing" {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+func TestDoWithoutBodyOrToken(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := r.Header["Authorization"]; ok {
+			t.Errorf("Authorization header sent without token: %q", r.Header.Get("Authorization"))
+		}
+		if _, ok := r.Header["Content-Type"]; ok {
+			t.Errorf("Content-Type header sent without body: %q", r.Header.Get("Content-Type"))
+		}
+		_, _ = w.Write([]byte(`true`))
+	}))
+	defer ts.Close()
+
+	if err := New(ts.URL, "", ts.Client()).Do(context.Background(), "DELETE", "/api/v1/things/t1", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestDoReturnsAPIError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"detail":"We could not find what you're looking for :/"}`))
+	}))
+	defer ts.Close()
+
+	err := New(ts.URL, "token", ts.Client()).Do(context.Background(), "GET", "/api/v1/things/t1", nil, nil)
+	if !errors.Is(err, apierror.ErrNotFound) {
+		t.Errorf("error = %v, want ErrNotFound", err)
+	}
+	var apiErr *apierror.APIError
+	if !errors.As(err, &apiErr) || apiErr.Method != "GET" || apiErr.Endpoint != "/api/v1/things/t1" {
+		t.Errorf("error = %#v, want the method and endpoint of the request", err)
+	}
+}
+
+func TestDoReportsInvalidResponse(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`not json`))
+	}))
+	defer ts.Close()
+
+	var result map[string]interface{}
+	err := New(ts.URL, "token", ts.Client()).Do(context.Background(), "GET", "/api/v1/things", nil, &result)
+	if err == nil {
+		t.Fatal("expected an error for an invalid response")
+	}
+	var apiErr *apierror.APIError
+	if errors.As(err, &apiErr) {
+		t.Errorf("error = %v, want a decoding error", err)
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+
+package knowledge
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+)
+
+// Client implements KnowledgeClient interface
+type Client struct {
+	endpoint   string
+	token      string
+	httpClient *http.Client
+}
+
+// NewClient creates a new knowledge client
+func NewClient(endpoint, token string, httpClient *http.Client) *Client {
+	return &Client{
+		endpoint:   endpoint,
+		token:      token,
+		httpClient: httpClient,
+	}
+}
+
+// Create creates a new knowledge base
+func (c *Client) Create(ctx context.Context, form *KnowledgeForm) (*KnowledgeResponse, error) {
+	body, err := json.Marshal(form)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling request: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/v1/knowledge/create", c.endpoint), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, apierror.FromResponse(resp)
+	}
+
+	var result KnowledgeResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("error decoding response: %v", err)
+	}
+
+	return &result, nil
+}
+
+// Get gets a knowledge base by ID
+func (c *Client) Get(ctx context.Context, id string) (*KnowledgeResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v1/knowledge/%s", c.endpoint, id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("knowledge base %s: %w", id, apierror.New(resp, body))
+	}
+
+	var result KnowledgeResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("error decoding response: %v", err)
+	}
+
+	return &result, nil
+}
+
+// List gets all knowledge bases
+func (c *Client) List(ctx context.Context) ([]KnowledgeResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v1/knowledge/", c.endpoint), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, apierror.FromResponse(resp)
+	}
+
+	var result []KnowledgeResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("error decoding response: %v", err)
+	}
+
+	return result, nil
+}
+
+// Update updates a knowledge base
+func (c *Client) Update(ctx context.Context, id string, form *KnowledgeForm) (*KnowledgeResponse, error) {
+	body, err := json.Marshal(form)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling request: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/v1/knowledge/%s/update", c.endpoint, id), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, apierror.FromResponse(resp)
+	}
+
+	var result KnowledgeResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("error decoding response: %v", err)
+	}
+
+	return &result, nil
+}
+
+// Delete deletes a knowledge base
+func (c *Client) Delete(ctx context.Context, id string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/api/v1/knowledge/%s/delete", c.endpoint, id), nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %v", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return apierror.FromResponse(resp)
+	}
+
+	return nil
+}
+
+// AddFile attaches an uploaded file to a knowledge base, which processes it into the collection
+func (c *Client) AddFile(ctx context.Context, id, fileID string) (*KnowledgeResponse, error) {
+	return c.sendFile(ctx, fmt.Sprintf("%s/api/v1/knowledge/%s/file/add", c.endpoint, id), fileID)
+}
+
+// RemoveFile detaches a file from a knowledge base
+func (c *Client) RemoveFile(ctx context.Context, id, fileID string) (*KnowledgeResponse, error) {
+	return c.sendFile(ctx, fmt.Sprintf("%s/api/v1/knowledge/%s/file/remove", c.endpoint, id), fileID)
+}
+
+func (c *Client) sendFile(ctx context.Context, url, fileID string) (*KnowledgeResponse, error) {
+	body, err := json.Marshal(&KnowledgeFileIDForm{FileID: fileID})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling request: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, apierror.FromResponse(resp)
+	}
+
+	var result KnowledgeResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("error decoding response: %v", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,61 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package knowledge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+)
+
+func TestAddFile(t *testing.T) {
+	var form KnowledgeFileIDForm
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v1/knowledge/k1/file/add" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&form)
+		_, _ = w.Write([]byte(`{"id":"k1","name":"docs"}`))
+	}))
+	defer ts.Close()
+
+	result, err := NewClient(ts.URL, "token", ts.Client()).AddFile(context.Background(), "k1", "f1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ID != "k1" {
+		t.Errorf("ID = %q, want %q", result.ID, "k1")
+	}
+	if form.FileID != "f1" {
+		t.Errorf("sent file_id = %q, want %q", form.FileID, "f1")
+	}
+}
+
+func TestAddFileDuplicate(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"detail":"Duplicate content detected. Please provide unique content to proceed."}`))
+	}))
+	defer ts.Close()
+
+	_, err := NewClient(ts.URL, "token", ts.Client()).AddFile(context.Background(), "k1", "f1")
+
+	var apiErr *apierror.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("error = %v, want an APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusBadRequest || apiErr.Message != "Duplicate content detected. Please provide unique content to proceed." {
+		t.Errorf("unexpected error details: %+v", apiErr)
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+
+package knowledge
+
+import (
+	"encoding/json"
+)
+
+// KnowledgeClient defines the interface for knowledge operations
+type KnowledgeClient interface {
+	Create(form *KnowledgeForm) (*KnowledgeResponse, error)
+	Get(id string) (*KnowledgeResponse, error)
+	List() ([]KnowledgeResponse, error)
+	Update(id string, form *KnowledgeForm) (*KnowledgeResponse, error)
+	Delete(id string) error
+	AddFile(id, fileID string) (*KnowledgeResponse, error)
+	RemoveFile(id, fileID string) (*KnowledgeResponse, error)
+}
+
+// KnowledgeForm represents the form data for creating/updating a knowledge base
+type KnowledgeForm struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Data        map[string]string `json:"data,omitempty"`
+	// AccessControl is sent as null to make the knowledge base public
+	AccessControl *AccessControl `json:"access_control"`
+}
+
+// AccessControl represents the users and groups allowed to access a private knowledge base
+type AccessControl struct {
+	Read  AccessGroup `json:"read"`
+	Write AccessGroup `json:"write"`
+}
+
+// AccessGroup represents the users and groups granted a single permission
+type AccessGroup struct {
+	GroupIDs []string `json:"group_ids"`
+	UserIDs  []string `json:"user_ids"`
+}
+
+// KnowledgeFileIDForm represents the form data for attaching or detaching a file
+type KnowledgeFileIDForm struct {
+	FileID string `json:"file_id"`
+}
+
+// KnowledgeResponse represents the API response for a knowledge base
+type KnowledgeResponse struct {
+	ID            string                 `json:"id"`
+	Name          string                 `json:"name"`
+	Description   string                 `json:"description"`
+	Data          map[string]interface{} `json:"data,omitempty"`
+	AccessControl *AccessControl         `json:"access_control,omitempty"`
+	Files         []KnowledgeFile        `json:"files,omitempty"`
+	UpdatedAt     int64                  `json:"updated_at"`
+	CreatedAt     int64                  `json:"created_at"`
+}
+
+// KnowledgeFile represents a file attached to a knowledge base
+type KnowledgeFile struct {
+	ID       string            `json:"id"`
+	Filename string            `json:"filename,omitempty"`
+	Meta     KnowledgeFileMeta `json:"meta"`
+}
+
+// KnowledgeFileMeta holds the metadata recorded for an attached file
+type KnowledgeFileMeta struct {
+	Name        *string `json:"name,omitempty"`
+	ContentType *string `json:"content_type,omitempty"`
+	Size        *int64  `json:"size,omitempty"`
+}
+
+// Name returns the original name of the file
+func (f *KnowledgeFile) Name() string {
+	if f.Meta.Name != nil {
+		return *f.Meta.Name
+	}
+	return f.Filename
+}
+
+// UnmarshalJSON implements custom JSON unmarshaling for KnowledgeResponse
+func (k *KnowledgeResponse) UnmarshalJSON(data []byte) error {
+	type Alias KnowledgeResponse
+	aux := &struct {
+		*Alias
+	}{
+		Alias: (*Alias)(k),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FileIDs returns the IDs of the files attached to the knowledge base
+func (k *KnowledgeResponse) FileIDs() []string {
+	var ids []string
+	if k.Files != nil {
+		for _, f := range k.Files {
+			ids = append(ids, f.ID)
+		}
+		return ids
+	}
+	if k.Data == nil {
+		return ids
+	}
+	if raw, ok := k.Data["file_ids"].([]interface{}); ok {
+		for _, v := range raw {
+			if id, ok := v.(string); ok {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
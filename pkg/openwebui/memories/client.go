@@ -0,0 +1,75 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package memories
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/internal/rest"
+)
+
+// Client implements the memory operations. Memories always belong to the
+// user of the token.
+type Client struct {
+	api *rest.Client
+}
+
+// NewClient creates a new memories client
+func NewClient(endpoint, token string, httpClient *http.Client) *Client {
+	return &Client{api: rest.New(endpoint, token, httpClient)}
+}
+
+// List gets all memories of the authenticated user
+func (c *Client) List(ctx context.Context) ([]Memory, error) {
+	var result []Memory
+	if err := c.api.Do(ctx, "GET", "/api/v1/memories/", nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Get gets a memory of the authenticated user by ID. The API has no lookup
+// by ID, so the memories are listed.
+func (c *Client) Get(ctx context.Context, id string) (*Memory, error) {
+	memories, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range memories {
+		if memories[i].ID == id {
+			return &memories[i], nil
+		}
+	}
+	return nil, fmt.Errorf("memory %s: %w", id, apierror.ErrNotFound)
+}
+
+// Add adds a memory for the authenticated user
+func (c *Client) Add(ctx context.Context, form *MemoryForm) (*Memory, error) {
+	var result Memory
+	if err := c.api.Do(ctx, "POST", "/api/v1/memories/add", form, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Update changes the content of a memory
+func (c *Client) Update(ctx context.Context, id string, form *MemoryForm) (*Memory, error) {
+	var result Memory
+	if err := c.api.Do(ctx, "POST", fmt.Sprintf("/api/v1/memories/%s/update", url.PathEscape(id)), form, &result); err != nil {
+		return nil, fmt.Errorf("memory %s: %w", id, err)
+	}
+	return &result, nil
+}
+
+// Delete deletes a memory
+func (c *Client) Delete(ctx context.Context, id string) error {
+	if err := c.api.Do(ctx, "DELETE", fmt.Sprintf("/api/v1/memories/%s", url.PathEscape(id)), nil, nil); err != nil {
+		return fmt.Errorf("memory %s: %w", id, err)
+	}
+	return nil
+}
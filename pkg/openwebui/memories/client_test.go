@@ -0,0 +1,65 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package memories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+)
+
+func TestAddAndGet(t *testing.T) {
+	var memories []Memory
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/v1/memories/add":
+			var form MemoryForm
+			_ = json.NewDecoder(r.Body).Decode(&form)
+			memories = append(memories, Memory{ID: "m1", UserID: "u1", Content: form.Content})
+			_ = json.NewEncoder(w).Encode(memories[len(memories)-1])
+		case r.Method == "GET" && r.URL.Path == "/api/v1/memories/":
+			_ = json.NewEncoder(w).Encode(memories)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(ts.URL, "token", ts.Client())
+	ctx := context.Background()
+
+	added, err := client.Add(ctx, &MemoryForm{Content: "Prefers metric units"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	memory, err := client.Get(ctx, added.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if memory.Content != "Prefers metric units" {
+		t.Errorf("content = %q", memory.Content)
+	}
+
+	if _, err := client.Get(ctx, "missing"); !errors.Is(err, apierror.ErrNotFound) {
+		t.Errorf("error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestDeleteUnauthorized(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"detail":"Not authenticated"}`))
+	}))
+	defer ts.Close()
+
+	err := NewClient(ts.URL, "token", ts.Client()).Delete(context.Background(), "m1")
+	if !errors.Is(err, apierror.ErrUnauthorized) {
+		t.Errorf("error = %v, want ErrUnauthorized", err)
+	}
+}
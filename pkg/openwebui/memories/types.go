@@ -0,0 +1,19 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package memories
+
+// Memory represents a memory of a user, which is added to the context of
+// their chats when memories are enabled
+type Memory struct {
+	ID        string `json:"id"`
+	UserID    string `json:"user_id"`
+	Content   string `json:"content"`
+	CreatedAt int64  `json:"created_at"`
+	UpdatedAt int64  `json:"updated_at"`
+}
+
+// MemoryForm represents the form data for adding/updating a memory
+type MemoryForm struct {
+	Content string `json:"content"`
+}
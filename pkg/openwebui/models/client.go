@@ -0,0 +1,284 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+)
+
+// Client implements the models operations
+type Client struct {
+	endpoint   string
+	token      string
+	httpClient *http.Client
+}
+
+// NewClient creates a new models client
+func NewClient(endpoint, token string, httpClient *http.Client) *Client {
+	return &Client{
+		endpoint:   endpoint,
+		token:      token,
+		httpClient: httpClient,
+	}
+}
+
+func (c *Client) GetModel(ctx context.Context, id string) (*Model, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v1/models/model?id=%s", c.endpoint, id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, _ := ioutil.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("model %s: %w", id, apierror.New(resp, bodyBytes))
+	}
+
+	var apiModel APIModel
+	if err := json.Unmarshal(bodyBytes, &apiModel); err != nil {
+		return nil, fmt.Errorf("error decoding response: %v", err)
+	}
+
+	return APIToModel(&apiModel), nil
+}
+
+func (c *Client) GetModels(ctx context.Context) ([]Model, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v1/models/", c.endpoint), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, _ := ioutil.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, apierror.New(resp, bodyBytes)
+	}
+
+	var apiModels []APIModel
+	if err := json.Unmarshal(bodyBytes, &apiModels); err != nil {
+		return nil, fmt.Errorf("error decoding response: %v", err)
+	}
+
+	var models []Model
+	for _, apiModel := range apiModels {
+		models = append(models, *APIToModel(&apiModel))
+	}
+
+	return models, nil
+}
+
+// GetBaseModels lists the models of the configured OpenAI and Ollama connections
+func (c *Client) GetBaseModels(ctx context.Context) ([]BaseModel, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/models/base", c.endpoint), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, _ := ioutil.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, apierror.New(resp, bodyBytes)
+	}
+
+	var baseModels BaseModelsResponse
+	if err := json.Unmarshal(bodyBytes, &baseModels); err != nil {
+		return nil, fmt.Errorf("error decoding response: %v", err)
+	}
+
+	return baseModels.Data, nil
+}
+
+func (c *Client) CreateModel(ctx context.Context, model *Model) (*Model, error) {
+	apiModel := ModelToAPI(model)
+
+	payload, err := json.Marshal(apiModel)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling model: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/v1/models/create", c.endpoint), bytes.NewBuffer(payload))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, _ := ioutil.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, apierror.New(resp, bodyBytes)
+	}
+
+	var createdAPIModel APIModel
+	if err := json.Unmarshal(bodyBytes, &createdAPIModel); err != nil {
+		return nil, fmt.Errorf("error decoding response: %v", err)
+	}
+
+	return APIToModel(&createdAPIModel), nil
+}
+
+// UpdateModel updates a model using fetch-merge-put semantics. The server only
+// supports replacing a model as a whole, so the changes between prior and model
+// are computed as a JSON merge patch and applied on top of the model currently
+// stored on the server. Fields the provider does not manage, and fields edited
+// concurrently in the UI that Terraform did not change, are preserved.
+// A nil prior sends every managed field.
+func (c *Client) UpdateModel(ctx context.Context, id string, prior *Model, model *Model) (*Model, error) {
+	current, err := c.getRawModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var priorDoc map[string]interface{}
+	if prior != nil {
+		priorDoc, err = toDocument(ModelToAPI(prior))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	plannedAPIModel := ModelToAPI(model)
+	plannedAPIModel.ID = id
+	plannedDoc, err := toDocument(plannedAPIModel)
+	if err != nil {
+		return nil, err
+	}
+
+	apiModel := mergePatch(current, diffDocuments(priorDoc, plannedDoc))
+	apiModel["id"] = id
+	// meta and params are required by the update form
+	for _, key := range []string{"meta", "params"} {
+		if _, ok := apiModel[key].(map[string]interface{}); !ok {
+			apiModel[key] = map[string]interface{}{}
+		}
+	}
+
+	payload, err := json.Marshal(apiModel)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling model: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/v1/models/model/update?id=%s", c.endpoint, id), bytes.NewBuffer(payload))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, _ := ioutil.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, apierror.New(resp, bodyBytes)
+	}
+
+	var updatedAPIModel APIModel
+	if err := json.Unmarshal(bodyBytes, &updatedAPIModel); err != nil {
+		return nil, fmt.Errorf("error decoding response: %v", err)
+	}
+
+	// Ensure the ID is preserved
+	if updatedAPIModel.ID == "" {
+		updatedAPIModel.ID = id
+	}
+
+	return APIToModel(&updatedAPIModel), nil
+}
+
+// getRawModel fetches a model as an untyped JSON document, keeping fields the
+// provider does not know about.
+func (c *Client) getRawModel(ctx context.Context, id string) (map[string]interface{}, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v1/models/model?id=%s", c.endpoint, id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, _ := ioutil.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, apierror.New(resp, bodyBytes)
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal(bodyBytes, &raw); err != nil {
+		return nil, fmt.Errorf("error decoding response: %v", err)
+	}
+
+	return raw, nil
+}
+
+func (c *Client) DeleteModel(ctx context.Context, id string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/api/v1/models/model/delete?id=%s", c.endpoint, id), nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %v", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, _ := ioutil.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return apierror.New(resp, bodyBytes)
+	}
+
+	return nil
+}
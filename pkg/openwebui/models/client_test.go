@@ -0,0 +1,172 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// fakeServer serves a single model document and records the last update payload.
+type fakeServer struct {
+	current map[string]interface{}
+	updated map[string]interface{}
+}
+
+func (f *fakeServer) handler(t *testing.T) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/models/model":
+			_ = json.NewEncoder(w).Encode(f.current)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/models/model/update":
+			body, err := io.ReadAll(r.Body)
+			if err == nil {
+				err = json.Unmarshal(body, &f.updated)
+			}
+			if err != nil {
+				t.Errorf("decoding update body: %v", err)
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			_, _ = w.Write(body)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+		}
+	})
+}
+
+func newTestModel() *Model {
+	return &Model{
+		ID:          types.StringValue("assistant"),
+		BaseModelID: types.StringValue("gpt-4o"),
+		Name:        types.StringValue("Assistant"),
+		IsActive:    types.BoolValue(true),
+		Params: &ModelParams{
+			System:      types.StringValue("You are helpful."),
+			Temperature: types.Float64Value(0.5),
+		},
+		Meta: &ModelMeta{
+			Description: types.StringValue("Original description"),
+		},
+	}
+}
+
+func serverDocument() map[string]interface{} {
+	return map[string]interface{}{
+		"id":            "assistant",
+		"base_model_id": "gpt-4o",
+		"name":          "Assistant",
+		"is_active":     true,
+		"params": map[string]interface{}{
+			"system":      "You are helpful.",
+			"temperature": 0.5,
+		},
+		"meta": map[string]interface{}{
+			"description": "Original description",
+		},
+	}
+}
+
+func TestUpdateModelPreservesUnmanagedFields(t *testing.T) {
+	server := &fakeServer{current: serverDocument()}
+	server.current["meta"].(map[string]interface{})["toolIds"] = []interface{}{"web_search"}
+	server.current["params"].(map[string]interface{})["custom_param"] = "kept"
+
+	ts := httptest.NewServer(server.handler(t))
+	defer ts.Close()
+
+	prior := newTestModel()
+	planned := newTestModel()
+	planned.Meta.Description = types.StringValue("New description")
+
+	if _, err := NewClient(ts.URL, "token", ts.Client()).UpdateModel(context.Background(), "assistant", prior, planned); err != nil {
+		t.Fatalf("UpdateModel returned error: %v", err)
+	}
+
+	meta := server.updated["meta"].(map[string]interface{})
+	if got := meta["description"]; got != "New description" {
+		t.Errorf("description = %v, want %q", got, "New description")
+	}
+	if got, ok := meta["toolIds"].([]interface{}); !ok || len(got) != 1 || got[0] != "web_search" {
+		t.Errorf("toolIds = %v, want [web_search]", meta["toolIds"])
+	}
+	if got := server.updated["params"].(map[string]interface{})["custom_param"]; got != "kept" {
+		t.Errorf("custom_param = %v, want %q", got, "kept")
+	}
+}
+
+func TestUpdateModelPreservesConcurrentEdits(t *testing.T) {
+	// The temperature was changed in the UI after Terraform refreshed its state.
+	server := &fakeServer{current: serverDocument()}
+	server.current["params"].(map[string]interface{})["temperature"] = 0.9
+
+	ts := httptest.NewServer(server.handler(t))
+	defer ts.Close()
+
+	prior := newTestModel()
+	planned := newTestModel()
+	planned.Name = types.StringValue("Renamed Assistant")
+
+	if _, err := NewClient(ts.URL, "token", ts.Client()).UpdateModel(context.Background(), "assistant", prior, planned); err != nil {
+		t.Fatalf("UpdateModel returned error: %v", err)
+	}
+
+	if got := server.updated["name"]; got != "Renamed Assistant" {
+		t.Errorf("name = %v, want %q", got, "Renamed Assistant")
+	}
+	if got := server.updated["params"].(map[string]interface{})["temperature"]; got != 0.9 {
+		t.Errorf("temperature = %v, want concurrent edit 0.9 to be preserved", got)
+	}
+}
+
+func TestUpdateModelOverridesConflictingEdits(t *testing.T) {
+	// Both the UI and Terraform changed the temperature; the planned value wins.
+	server := &fakeServer{current: serverDocument()}
+	server.current["params"].(map[string]interface{})["temperature"] = 0.9
+
+	ts := httptest.NewServer(server.handler(t))
+	defer ts.Close()
+
+	prior := newTestModel()
+	planned := newTestModel()
+	planned.Params.Temperature = types.Float64Value(0.2)
+
+	if _, err := NewClient(ts.URL, "token", ts.Client()).UpdateModel(context.Background(), "assistant", prior, planned); err != nil {
+		t.Fatalf("UpdateModel returned error: %v", err)
+	}
+
+	if got := server.updated["params"].(map[string]interface{})["temperature"]; got != 0.2 {
+		t.Errorf("temperature = %v, want 0.2", got)
+	}
+}
+
+func TestUpdateModelRemovesUnsetFields(t *testing.T) {
+	server := &fakeServer{current: serverDocument()}
+
+	ts := httptest.NewServer(server.handler(t))
+	defer ts.Close()
+
+	prior := newTestModel()
+	planned := newTestModel()
+	planned.Params.System = types.StringNull()
+
+	if _, err := NewClient(ts.URL, "token", ts.Client()).UpdateModel(context.Background(), "assistant", prior, planned); err != nil {
+		t.Fatalf("UpdateModel returned error: %v", err)
+	}
+
+	params := server.updated["params"].(map[string]interface{})
+	if _, ok := params["system"]; ok {
+		t.Errorf("system = %v, want it to be removed", params["system"])
+	}
+	if got := params["temperature"]; got != 0.5 {
+		t.Errorf("temperature = %v, want 0.5", got)
+	}
+}
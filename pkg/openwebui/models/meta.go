@@ -0,0 +1,87 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// apiModelMetaFields is the alias used to encode the modeled meta fields
+// without recursing into the custom JSON methods of APIModelMeta
+type apiModelMetaFields APIModelMeta
+
+// MetaFieldNames returns the JSON names of the meta fields modeled by APIModelMeta
+func MetaFieldNames() map[string]bool {
+	names := make(map[string]bool)
+	metaType := reflect.TypeOf(APIModelMeta{})
+	for i := 0; i < metaType.NumField(); i++ {
+		name, _, _ := strings.Cut(metaType.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			names[name] = true
+		}
+	}
+	return names
+}
+
+// MarshalJSON encodes the modeled meta fields together with the extra ones.
+// Modeled fields take precedence over extra fields of the same name.
+func (m APIModelMeta) MarshalJSON() ([]byte, error) {
+	payload, err := json.Marshal(apiModelMetaFields(m))
+	if err != nil || len(m.Extra) == 0 {
+		return payload, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return nil, err
+	}
+	for key, value := range m.Extra {
+		if _, ok := fields[key]; !ok {
+			fields[key] = value
+		}
+	}
+
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON decodes the modeled meta fields and keeps all other fields in Extra
+func (m *APIModelMeta) UnmarshalJSON(data []byte) error {
+	var fields apiModelMetaFields
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return err
+	}
+	for name := range MetaFieldNames() {
+		delete(all, name)
+	}
+
+	*m = APIModelMeta(fields)
+	m.Extra = nil
+	if len(all) > 0 {
+		m.Extra = all
+	}
+
+	return nil
+}
+
+// NormalizeJSON re-encodes a JSON document with sorted object keys and without
+// insignificant whitespace, so that equal documents have equal encodings
+func NormalizeJSON(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(value)
+}
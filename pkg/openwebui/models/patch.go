@@ -0,0 +1,88 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// toDocument converts an API model to an untyped JSON document
+func toDocument(apiModel *APIModel) (map[string]interface{}, error) {
+	payload, err := json.Marshal(apiModel)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling model: %v", err)
+	}
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal(payload, &doc); err != nil {
+		return nil, fmt.Errorf("error decoding model: %v", err)
+	}
+
+	return doc, nil
+}
+
+// diffDocuments computes a JSON merge patch (RFC 7396) that turns prior into
+// planned. Nested objects are diffed recursively, arrays and scalars are
+// replaced as a whole and removed keys are marked with a nil value.
+func diffDocuments(prior, planned map[string]interface{}) map[string]interface{} {
+	patch := make(map[string]interface{})
+
+	for key, plannedValue := range planned {
+		priorValue, ok := prior[key]
+		if ok && reflect.DeepEqual(priorValue, plannedValue) {
+			continue
+		}
+
+		plannedObject, plannedIsObject := plannedValue.(map[string]interface{})
+		priorObject, priorIsObject := priorValue.(map[string]interface{})
+		if plannedIsObject && priorIsObject {
+			if nested := diffDocuments(priorObject, plannedObject); len(nested) > 0 {
+				patch[key] = nested
+			}
+			continue
+		}
+
+		patch[key] = plannedValue
+	}
+
+	for key := range prior {
+		if _, ok := planned[key]; !ok {
+			patch[key] = nil
+		}
+	}
+
+	return patch
+}
+
+// mergePatch applies a JSON merge patch (RFC 7396) to target and returns the result.
+// The target document is modified in place.
+func mergePatch(target, patch map[string]interface{}) map[string]interface{} {
+	if target == nil {
+		target = make(map[string]interface{})
+	}
+
+	for key, value := range patch {
+		if value == nil {
+			delete(target, key)
+			continue
+		}
+
+		patchObject, patchIsObject := value.(map[string]interface{})
+		targetObject, targetIsObject := target[key].(map[string]interface{})
+		if patchIsObject && targetIsObject {
+			target[key] = mergePatch(targetObject, patchObject)
+			continue
+		}
+		if patchIsObject {
+			target[key] = mergePatch(nil, patchObject)
+			continue
+		}
+
+		target[key] = value
+	}
+
+	return target
+}
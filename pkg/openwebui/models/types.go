@@ -0,0 +1,796 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Model represents the Terraform schema model
+type Model struct {
+	ID            types.String   `tfsdk:"id"`
+	UserID        types.String   `tfsdk:"user_id"`
+	BaseModelID   types.String   `tfsdk:"base_model_id"`
+	Name          types.String   `tfsdk:"name"`
+	Params        *ModelParams   `tfsdk:"params"`
+	Meta          *ModelMeta     `tfsdk:"meta"`
+	AccessControl *AccessControl `tfsdk:"access_control"`
+	MetaJSON      types.String   `tfsdk:"meta_json"`
+	IsActive      types.Bool     `tfsdk:"is_active"`
+	IsPrivate     types.Bool     `tfsdk:"is_private"`
+	UpdatedAt     types.Int64    `tfsdk:"updated_at"`
+	CreatedAt     types.Int64    `tfsdk:"created_at"`
+}
+
+// APIModel represents the API response model
+type APIModel struct {
+	ID            string            `json:"id"`
+	UserID        string            `json:"user_id"`
+	BaseModelID   string            `json:"base_model_id"`
+	Name          string            `json:"name"`
+	Params        *APIModelParams   `json:"params,omitempty"`
+	Meta          *APIModelMeta     `json:"meta,omitempty"`
+	AccessControl *APIAccessControl `json:"access_control,omitempty"`
+	IsActive      bool              `json:"is_active"`
+	UpdatedAt     int64             `json:"updated_at"`
+	CreatedAt     int64             `json:"created_at"`
+}
+
+type ModelParams struct {
+	System           types.String           `tfsdk:"system"`
+	StreamResponse   types.Bool             `tfsdk:"stream_response"`
+	Seed             types.Int64            `tfsdk:"seed"`
+	Temperature      types.Float64          `tfsdk:"temperature"`
+	ReasoningEffort  types.String           `tfsdk:"reasoning_effort"`
+	TopK             types.Int64            `tfsdk:"top_k"`
+	TopP             types.Float64          `tfsdk:"top_p"`
+	MinP             types.Float64          `tfsdk:"min_p"`
+	FrequencyPenalty types.Float64          `tfsdk:"frequency_penalty"`
+	RepeatLastN      types.Int64            `tfsdk:"repeat_last_n"`
+	NumCtx           types.Int64            `tfsdk:"num_ctx"`
+	NumBatch         types.Int64            `tfsdk:"num_batch"`
+	NumKeep          types.Int64            `tfsdk:"num_keep"`
+	MaxTokens        types.Int64            `tfsdk:"max_tokens"`
+	FunctionCalling  types.String           `tfsdk:"function_calling"`
+	Stop             []types.String         `tfsdk:"stop"`
+	LogitBias        map[string]types.Int64 `tfsdk:"logit_bias"`
+	Mirostat         types.Int64            `tfsdk:"mirostat"`
+	MirostatEta      types.Float64          `tfsdk:"mirostat_eta"`
+	MirostatTau      types.Float64          `tfsdk:"mirostat_tau"`
+	NumGPU           types.Int64            `tfsdk:"num_gpu"`
+	NumThread        types.Int64            `tfsdk:"num_thread"`
+	RepeatPenalty    types.Float64          `tfsdk:"repeat_penalty"`
+	TfsZ             types.Float64          `tfsdk:"tfs_z"`
+	UseMmap          types.Bool             `tfsdk:"use_mmap"`
+	UseMlock         types.Bool             `tfsdk:"use_mlock"`
+	PresencePenalty  types.Float64          `tfsdk:"presence_penalty"`
+}
+
+// APIModelParams represents the API model parameters
+// FunctionCalling is a pointer so that it is omitted when not set
+// The API accepts "default" or "native", or the value completely
+// omitted to use the server default.
+// Numeric params whose valid range includes 0, such as temperature, top_p,
+// the penalties and Mirostat, are pointers as well, because 0 must be sent
+// rather than omitted.
+type APIModelParams struct {
+	System           string    `json:"system,omitempty"`
+	StreamResponse   *bool     `json:"stream_response,omitempty"`
+	Seed             int64     `json:"seed,omitempty"`
+	Temperature      *float64  `json:"temperature,omitempty"`
+	ReasoningEffort  string    `json:"reasoning_effort,omitempty"`
+	TopK             *int64    `json:"top_k,omitempty"`
+	TopP             *float64  `json:"top_p,omitempty"`
+	MinP             *float64  `json:"min_p,omitempty"`
+	FrequencyPenalty *float64  `json:"frequency_penalty,omitempty"`
+	RepeatLastN      *int64    `json:"repeat_last_n,omitempty"`
+	NumCtx           int64     `json:"num_ctx,omitempty"`
+	NumBatch         int64     `json:"num_batch,omitempty"`
+	NumKeep          int64     `json:"num_keep,omitempty"`
+	MaxTokens        int64     `json:"max_tokens,omitempty"`
+	FunctionCalling  *string   `json:"function_calling,omitempty"`
+	Stop             []string  `json:"stop,omitempty"`
+	LogitBias        LogitBias `json:"logit_bias,omitempty"`
+	Mirostat         *int64    `json:"mirostat,omitempty"`
+	MirostatEta      *float64  `json:"mirostat_eta,omitempty"`
+	MirostatTau      *float64  `json:"mirostat_tau,omitempty"`
+	NumGPU           *int64    `json:"num_gpu,omitempty"`
+	NumThread        *int64    `json:"num_thread,omitempty"`
+	RepeatPenalty    *float64  `json:"repeat_penalty,omitempty"`
+	TfsZ             *float64  `json:"tfs_z,omitempty"`
+	UseMmap          *bool     `json:"use_mmap,omitempty"`
+	UseMlock         *bool     `json:"use_mlock,omitempty"`
+	PresencePenalty  *float64  `json:"presence_penalty,omitempty"`
+}
+
+// LogitBias maps token IDs to their bias. The UI stores it as a string of
+// comma separated "token:bias" pairs, which is also what the server expects
+// when applying the params to a request.
+type LogitBias map[string]int64
+
+// MarshalJSON encodes the biases as comma separated "token:bias" pairs
+func (l LogitBias) MarshalJSON() ([]byte, error) {
+	tokens := make([]string, 0, len(l))
+	for token := range l {
+		tokens = append(tokens, token)
+	}
+	sort.Strings(tokens)
+
+	pairs := make([]string, len(tokens))
+	for i, token := range tokens {
+		pairs[i] = fmt.Sprintf("%s:%d", token, l[token])
+	}
+
+	return json.Marshal(strings.Join(pairs, ","))
+}
+
+// UnmarshalJSON decodes the biases from a "token:bias" string or a JSON object
+func (l *LogitBias) UnmarshalJSON(data []byte) error {
+	var object map[string]float64
+	if err := json.Unmarshal(data, &object); err == nil {
+		*l = make(LogitBias, len(object))
+		for token, bias := range object {
+			(*l)[token] = int64(bias)
+		}
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("logit_bias must be a string or an object: %v", err)
+	}
+
+	*l = LogitBias{}
+	for _, pair := range strings.Split(value, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		token, bias, ok := strings.Cut(pair, ":")
+		if !ok {
+			return fmt.Errorf("invalid logit_bias pair %q", pair)
+		}
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(bias), 64)
+		if err != nil {
+			return fmt.Errorf("invalid logit_bias for token %q: %v", token, err)
+		}
+		(*l)[strings.TrimSpace(token)] = int64(parsed)
+	}
+
+	return nil
+}
+
+// ModelMeta holds model metadata
+type ModelMeta struct {
+	ProfileImageURL   types.String       `tfsdk:"profile_image_url"`
+	Description       types.String       `tfsdk:"description"`
+	Capabilities      *ModelCapabilities `tfsdk:"capabilities"`
+	Tags              []Tag              `tfsdk:"tags"`
+	FilterIDs         []types.String     `tfsdk:"filter_ids"`
+	ToolIDs           []types.String     `tfsdk:"tool_ids"`
+	KnowledgeIDs      []types.String     `tfsdk:"knowledge_ids"`
+	SuggestionPrompts []SuggestionPrompt `tfsdk:"suggestion_prompts"`
+	DefaultFeatures   *DefaultFeatures   `tfsdk:"default_features"`
+}
+
+type APIModelMeta struct {
+	ProfileImageURL   string                `json:"profile_image_url,omitempty"`
+	Description       string                `json:"description,omitempty"`
+	Capabilities      *APIModelCapabilities `json:"capabilities,omitempty"`
+	Tags              []APITag              `json:"tags,omitempty"`
+	FilterIDs         []string              `json:"filterIds,omitempty"`
+	ToolIDs           []string              `json:"toolIds,omitempty"`
+	Knowledge         []APIModelKnowledge   `json:"knowledge,omitempty"`
+	SuggestionPrompts []APISuggestionPrompt `json:"suggestion_prompts,omitempty"`
+	DefaultFeatureIDs *[]string             `json:"defaultFeatureIds,omitempty"`
+
+	// Extra holds the meta fields that are not modeled above
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// APIModelKnowledge is a knowledge base or file attached to a model. The UI stores
+// the whole knowledge object, but only the ID and type are needed for retrieval.
+type APIModelKnowledge struct {
+	ID   string `json:"id"`
+	Type string `json:"type,omitempty"`
+}
+
+// knowledgeTypeCollection is the type of knowledge bases attached to a model
+const knowledgeTypeCollection = "collection"
+
+// DefaultFeatures are the features enabled by default in new chats with the model.
+// The API stores them as a list of feature IDs, which is a pointer in
+// APIModelMeta so that an empty list is kept apart from an unset one.
+type DefaultFeatures struct {
+	WebSearch       types.Bool `tfsdk:"web_search"`
+	ImageGeneration types.Bool `tfsdk:"image_generation"`
+	CodeInterpreter types.Bool `tfsdk:"code_interpreter"`
+}
+
+// Feature IDs used in the defaultFeatureIds meta field
+const (
+	featureWebSearch       = "web_search"
+	featureImageGeneration = "image_generation"
+	featureCodeInterpreter = "code_interpreter"
+)
+
+type ModelCapabilities struct {
+	Vision          types.Bool `tfsdk:"vision"`
+	Usage           types.Bool `tfsdk:"usage"`
+	Citations       types.Bool `tfsdk:"citations"`
+	WebSearch       types.Bool `tfsdk:"web_search"`
+	ImageGeneration types.Bool `tfsdk:"image_generation"`
+	CodeInterpreter types.Bool `tfsdk:"code_interpreter"`
+	FileUpload      types.Bool `tfsdk:"file_upload"`
+}
+
+type APIModelCapabilities struct {
+	Vision          bool `json:"vision,omitempty"`
+	Usage           bool `json:"usage,omitempty"`
+	Citations       bool `json:"citations,omitempty"`
+	WebSearch       bool `json:"web_search,omitempty"`
+	ImageGeneration bool `json:"image_generation,omitempty"`
+	CodeInterpreter bool `json:"code_interpreter,omitempty"`
+	FileUpload      bool `json:"file_upload,omitempty"`
+}
+
+type Tag struct {
+	Name types.String `tfsdk:"name"`
+}
+
+type APITag struct {
+	Name string `json:"name,omitempty"`
+}
+
+// SuggestionPrompt is a starter prompt shown on the new chat screen
+type SuggestionPrompt struct {
+	Content types.String   `tfsdk:"content"`
+	Title   []types.String `tfsdk:"title"`
+}
+
+type APISuggestionPrompt struct {
+	Content string   `json:"content"`
+	Title   []string `json:"title,omitempty"`
+}
+
+type AccessControl struct {
+	Read  *AccessGroup `tfsdk:"read"`
+	Write *AccessGroup `tfsdk:"write"`
+}
+
+type APIAccessControl struct {
+	Read  *APIAccessGroup `json:"read,omitempty"`
+	Write *APIAccessGroup `json:"write,omitempty"`
+}
+
+type AccessGroup struct {
+	GroupIDs []types.String `tfsdk:"group_ids"`
+	UserIDs  []types.String `tfsdk:"user_ids"`
+}
+
+type APIAccessGroup struct {
+	GroupIDs []string `json:"group_ids,omitempty"`
+	UserIDs  []string `json:"user_ids,omitempty"`
+}
+
+// BaseModel is a model exposed by one of the configured connections, e.g. OpenAI or Ollama
+type BaseModel struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	OwnedBy string `json:"owned_by"`
+}
+
+// BaseModelsResponse is the response of the base models endpoint
+type BaseModelsResponse struct {
+	Data []BaseModel `json:"data"`
+}
+
+// Helper function to convert API model to Terraform model
+func APIToModel(apiModel *APIModel) *Model {
+	model := &Model{
+		ID:          types.StringValue(apiModel.ID),
+		UserID:      types.StringValue(apiModel.UserID),
+		BaseModelID: types.StringValue(apiModel.BaseModelID),
+		Name:        types.StringValue(apiModel.Name),
+		IsActive:    types.BoolValue(apiModel.IsActive),
+		UpdatedAt:   types.Int64Value(apiModel.UpdatedAt),
+		CreatedAt:   types.Int64Value(apiModel.CreatedAt),
+	}
+
+	if apiModel.Params != nil {
+		model.Params = &ModelParams{}
+		if apiModel.Params.System != "" {
+			model.Params.System = types.StringValue(apiModel.Params.System)
+		}
+		if apiModel.Params.StreamResponse != nil {
+			model.Params.StreamResponse = types.BoolValue(*apiModel.Params.StreamResponse)
+		}
+		if apiModel.Params.Temperature != nil {
+			model.Params.Temperature = types.Float64Value(*apiModel.Params.Temperature)
+		}
+		if apiModel.Params.ReasoningEffort != "" {
+			model.Params.ReasoningEffort = types.StringValue(apiModel.Params.ReasoningEffort)
+		}
+		if apiModel.Params.TopP != nil {
+			model.Params.TopP = types.Float64Value(*apiModel.Params.TopP)
+		}
+		if apiModel.Params.MaxTokens != 0 {
+			model.Params.MaxTokens = types.Int64Value(apiModel.Params.MaxTokens)
+		}
+		if apiModel.Params.Seed != 0 {
+			model.Params.Seed = types.Int64Value(apiModel.Params.Seed)
+		}
+		if apiModel.Params.TopK != nil {
+			model.Params.TopK = types.Int64Value(*apiModel.Params.TopK)
+		}
+		if apiModel.Params.MinP != nil {
+			model.Params.MinP = types.Float64Value(*apiModel.Params.MinP)
+		}
+		if apiModel.Params.FrequencyPenalty != nil {
+			model.Params.FrequencyPenalty = types.Float64Value(*apiModel.Params.FrequencyPenalty)
+		}
+		if apiModel.Params.RepeatLastN != nil {
+			model.Params.RepeatLastN = types.Int64Value(*apiModel.Params.RepeatLastN)
+		}
+		if apiModel.Params.NumCtx != 0 {
+			model.Params.NumCtx = types.Int64Value(apiModel.Params.NumCtx)
+		}
+		if apiModel.Params.NumBatch != 0 {
+			model.Params.NumBatch = types.Int64Value(apiModel.Params.NumBatch)
+		}
+		if apiModel.Params.NumKeep != 0 {
+			model.Params.NumKeep = types.Int64Value(apiModel.Params.NumKeep)
+		}
+		if apiModel.Params.FunctionCalling != nil && *apiModel.Params.FunctionCalling != "" {
+			model.Params.FunctionCalling = types.StringValue(*apiModel.Params.FunctionCalling)
+		}
+		if len(apiModel.Params.Stop) > 0 {
+			model.Params.Stop = make([]types.String, len(apiModel.Params.Stop))
+			for i, stop := range apiModel.Params.Stop {
+				model.Params.Stop[i] = types.StringValue(stop)
+			}
+		}
+		if len(apiModel.Params.LogitBias) > 0 {
+			model.Params.LogitBias = make(map[string]types.Int64, len(apiModel.Params.LogitBias))
+			for token, bias := range apiModel.Params.LogitBias {
+				model.Params.LogitBias[token] = types.Int64Value(bias)
+			}
+		}
+		if apiModel.Params.Mirostat != nil {
+			model.Params.Mirostat = types.Int64Value(*apiModel.Params.Mirostat)
+		}
+		if apiModel.Params.MirostatEta != nil {
+			model.Params.MirostatEta = types.Float64Value(*apiModel.Params.MirostatEta)
+		}
+		if apiModel.Params.MirostatTau != nil {
+			model.Params.MirostatTau = types.Float64Value(*apiModel.Params.MirostatTau)
+		}
+		if apiModel.Params.NumGPU != nil {
+			model.Params.NumGPU = types.Int64Value(*apiModel.Params.NumGPU)
+		}
+		if apiModel.Params.NumThread != nil {
+			model.Params.NumThread = types.Int64Value(*apiModel.Params.NumThread)
+		}
+		if apiModel.Params.RepeatPenalty != nil {
+			model.Params.RepeatPenalty = types.Float64Value(*apiModel.Params.RepeatPenalty)
+		}
+		if apiModel.Params.TfsZ != nil {
+			model.Params.TfsZ = types.Float64Value(*apiModel.Params.TfsZ)
+		}
+		if apiModel.Params.UseMmap != nil {
+			model.Params.UseMmap = types.BoolValue(*apiModel.Params.UseMmap)
+		}
+		if apiModel.Params.UseMlock != nil {
+			model.Params.UseMlock = types.BoolValue(*apiModel.Params.UseMlock)
+		}
+		if apiModel.Params.PresencePenalty != nil {
+			model.Params.PresencePenalty = types.Float64Value(*apiModel.Params.PresencePenalty)
+		}
+	}
+
+	if apiModel.Meta != nil {
+		model.Meta = &ModelMeta{}
+		if apiModel.Meta.ProfileImageURL != "" {
+			model.Meta.ProfileImageURL = types.StringValue(apiModel.Meta.ProfileImageURL)
+		}
+		if apiModel.Meta.Description != "" {
+			model.Meta.Description = types.StringValue(apiModel.Meta.Description)
+		}
+
+		if apiModel.Meta.Capabilities != nil {
+			model.Meta.Capabilities = &ModelCapabilities{
+				Vision:          types.BoolValue(apiModel.Meta.Capabilities.Vision),
+				Usage:           types.BoolValue(apiModel.Meta.Capabilities.Usage),
+				Citations:       types.BoolValue(apiModel.Meta.Capabilities.Citations),
+				WebSearch:       types.BoolValue(apiModel.Meta.Capabilities.WebSearch),
+				ImageGeneration: types.BoolValue(apiModel.Meta.Capabilities.ImageGeneration),
+				CodeInterpreter: types.BoolValue(apiModel.Meta.Capabilities.CodeInterpreter),
+				FileUpload:      types.BoolValue(apiModel.Meta.Capabilities.FileUpload),
+			}
+		}
+
+		if len(apiModel.Meta.Tags) > 0 {
+			model.Meta.Tags = make([]Tag, len(apiModel.Meta.Tags))
+			for i, tag := range apiModel.Meta.Tags {
+				model.Meta.Tags[i] = Tag{
+					Name: types.StringValue(tag.Name),
+				}
+			}
+		}
+
+		if apiModel.Meta.DefaultFeatureIDs != nil {
+			model.Meta.DefaultFeatures = &DefaultFeatures{
+				WebSearch:       types.BoolValue(false),
+				ImageGeneration: types.BoolValue(false),
+				CodeInterpreter: types.BoolValue(false),
+			}
+			for _, id := range *apiModel.Meta.DefaultFeatureIDs {
+				switch id {
+				case featureWebSearch:
+					model.Meta.DefaultFeatures.WebSearch = types.BoolValue(true)
+				case featureImageGeneration:
+					model.Meta.DefaultFeatures.ImageGeneration = types.BoolValue(true)
+				case featureCodeInterpreter:
+					model.Meta.DefaultFeatures.CodeInterpreter = types.BoolValue(true)
+				}
+			}
+		}
+
+		if len(apiModel.Meta.SuggestionPrompts) > 0 {
+			model.Meta.SuggestionPrompts = make([]SuggestionPrompt, len(apiModel.Meta.SuggestionPrompts))
+			for i, prompt := range apiModel.Meta.SuggestionPrompts {
+				model.Meta.SuggestionPrompts[i] = SuggestionPrompt{
+					Content: types.StringValue(prompt.Content),
+				}
+				for _, title := range prompt.Title {
+					model.Meta.SuggestionPrompts[i].Title = append(model.Meta.SuggestionPrompts[i].Title, types.StringValue(title))
+				}
+			}
+		}
+
+		if len(apiModel.Meta.FilterIDs) > 0 {
+			model.Meta.FilterIDs = make([]types.String, len(apiModel.Meta.FilterIDs))
+			for i, id := range apiModel.Meta.FilterIDs {
+				model.Meta.FilterIDs[i] = types.StringValue(id)
+			}
+		}
+
+		if len(apiModel.Meta.ToolIDs) > 0 {
+			model.Meta.ToolIDs = make([]types.String, len(apiModel.Meta.ToolIDs))
+			for i, id := range apiModel.Meta.ToolIDs {
+				model.Meta.ToolIDs[i] = types.StringValue(id)
+			}
+		}
+
+		// Single files can be attached as well, only knowledge bases are managed
+		for _, knowledge := range apiModel.Meta.Knowledge {
+			if knowledge.Type == "file" {
+				continue
+			}
+			model.Meta.KnowledgeIDs = append(model.Meta.KnowledgeIDs, types.StringValue(knowledge.ID))
+		}
+	}
+
+	model.MetaJSON = types.StringNull()
+	if apiModel.Meta != nil && len(apiModel.Meta.Extra) > 0 {
+		if extra, err := json.Marshal(apiModel.Meta.Extra); err == nil {
+			if normalized, err := NormalizeJSON(extra); err == nil {
+				model.MetaJSON = types.StringValue(string(normalized))
+			}
+		}
+	}
+
+	if apiModel.AccessControl != nil {
+		model.AccessControl = &AccessControl{}
+		if apiModel.AccessControl.Read != nil {
+			model.AccessControl.Read = &AccessGroup{
+				GroupIDs: make([]types.String, len(apiModel.AccessControl.Read.GroupIDs)),
+				UserIDs:  make([]types.String, len(apiModel.AccessControl.Read.UserIDs)),
+			}
+			for i, id := range apiModel.AccessControl.Read.GroupIDs {
+				model.AccessControl.Read.GroupIDs[i] = types.StringValue(id)
+			}
+			for i, id := range apiModel.AccessControl.Read.UserIDs {
+				model.AccessControl.Read.UserIDs[i] = types.StringValue(id)
+			}
+		}
+		if apiModel.AccessControl.Write != nil {
+			model.AccessControl.Write = &AccessGroup{
+				GroupIDs: make([]types.String, len(apiModel.AccessControl.Write.GroupIDs)),
+				UserIDs:  make([]types.String, len(apiModel.AccessControl.Write.UserIDs)),
+			}
+			for i, id := range apiModel.AccessControl.Write.GroupIDs {
+				model.AccessControl.Write.GroupIDs[i] = types.StringValue(id)
+			}
+			for i, id := range apiModel.AccessControl.Write.UserIDs {
+				model.AccessControl.Write.UserIDs[i] = types.StringValue(id)
+			}
+		}
+		model.IsPrivate = types.BoolValue(true)
+	} else {
+		model.IsPrivate = types.BoolValue(false)
+	}
+
+	return model
+}
+
+// KeepEmptyCollections copies the empty lists, sets and maps of prior into m
+// where the server returned none. The API omits empty collections, so without
+// this a configured empty collection would turn into null after every apply.
+func (m *Model) KeepEmptyCollections(prior *Model) {
+	if prior == nil {
+		return
+	}
+
+	if prior.Params != nil && m.Params != nil {
+		if prior.Params.Stop != nil && len(prior.Params.Stop) == 0 && len(m.Params.Stop) == 0 {
+			m.Params.Stop = []types.String{}
+		}
+		if prior.Params.LogitBias != nil && len(prior.Params.LogitBias) == 0 && len(m.Params.LogitBias) == 0 {
+			m.Params.LogitBias = map[string]types.Int64{}
+		}
+	}
+
+	if prior.Meta != nil && m.Meta != nil {
+		if prior.Meta.Tags != nil && len(prior.Meta.Tags) == 0 && len(m.Meta.Tags) == 0 {
+			m.Meta.Tags = []Tag{}
+		}
+		if prior.Meta.FilterIDs != nil && len(prior.Meta.FilterIDs) == 0 && len(m.Meta.FilterIDs) == 0 {
+			m.Meta.FilterIDs = []types.String{}
+		}
+		if prior.Meta.ToolIDs != nil && len(prior.Meta.ToolIDs) == 0 && len(m.Meta.ToolIDs) == 0 {
+			m.Meta.ToolIDs = []types.String{}
+		}
+		if prior.Meta.KnowledgeIDs != nil && len(prior.Meta.KnowledgeIDs) == 0 && len(m.Meta.KnowledgeIDs) == 0 {
+			m.Meta.KnowledgeIDs = []types.String{}
+		}
+		if prior.Meta.SuggestionPrompts != nil && len(prior.Meta.SuggestionPrompts) == 0 && len(m.Meta.SuggestionPrompts) == 0 {
+			m.Meta.SuggestionPrompts = []SuggestionPrompt{}
+		}
+	}
+}
+
+// ModelToAPI converts a Terraform model to the API representation
+func ModelToAPI(model *Model) *APIModel {
+	apiModel := &APIModel{
+		ID:          model.ID.ValueString(),
+		BaseModelID: model.BaseModelID.ValueString(),
+		Name:        model.Name.ValueString(),
+		IsActive:    model.IsActive.ValueBool(),
+	}
+
+	// Handle Params
+	if model.Params != nil {
+		apiModel.Params = &APIModelParams{}
+		if !model.Params.System.IsNull() {
+			apiModel.Params.System = model.Params.System.ValueString()
+		}
+		if !model.Params.StreamResponse.IsNull() {
+			apiModel.Params.StreamResponse = model.Params.StreamResponse.ValueBoolPointer()
+		}
+		if !model.Params.Temperature.IsNull() {
+			apiModel.Params.Temperature = model.Params.Temperature.ValueFloat64Pointer()
+		}
+		if !model.Params.ReasoningEffort.IsNull() {
+			apiModel.Params.ReasoningEffort = model.Params.ReasoningEffort.ValueString()
+		}
+		if !model.Params.TopP.IsNull() {
+			apiModel.Params.TopP = model.Params.TopP.ValueFloat64Pointer()
+		}
+		if !model.Params.MaxTokens.IsNull() {
+			apiModel.Params.MaxTokens = model.Params.MaxTokens.ValueInt64()
+		}
+		if !model.Params.Seed.IsNull() {
+			apiModel.Params.Seed = model.Params.Seed.ValueInt64()
+		}
+		if !model.Params.TopK.IsNull() {
+			apiModel.Params.TopK = model.Params.TopK.ValueInt64Pointer()
+		}
+		if !model.Params.MinP.IsNull() {
+			apiModel.Params.MinP = model.Params.MinP.ValueFloat64Pointer()
+		}
+		if !model.Params.FrequencyPenalty.IsNull() {
+			apiModel.Params.FrequencyPenalty = model.Params.FrequencyPenalty.ValueFloat64Pointer()
+		}
+		if !model.Params.RepeatLastN.IsNull() {
+			apiModel.Params.RepeatLastN = model.Params.RepeatLastN.ValueInt64Pointer()
+		}
+		if !model.Params.NumCtx.IsNull() {
+			apiModel.Params.NumCtx = model.Params.NumCtx.ValueInt64()
+		}
+		if !model.Params.NumBatch.IsNull() {
+			apiModel.Params.NumBatch = model.Params.NumBatch.ValueInt64()
+		}
+		if !model.Params.NumKeep.IsNull() {
+			apiModel.Params.NumKeep = model.Params.NumKeep.ValueInt64()
+		}
+		if !model.Params.FunctionCalling.IsNull() {
+			apiModel.Params.FunctionCalling = model.Params.FunctionCalling.ValueStringPointer()
+		}
+		if len(model.Params.Stop) > 0 {
+			apiModel.Params.Stop = make([]string, len(model.Params.Stop))
+			for i, stop := range model.Params.Stop {
+				apiModel.Params.Stop[i] = stop.ValueString()
+			}
+		}
+		if len(model.Params.LogitBias) > 0 {
+			apiModel.Params.LogitBias = make(LogitBias, len(model.Params.LogitBias))
+			for token, bias := range model.Params.LogitBias {
+				apiModel.Params.LogitBias[token] = bias.ValueInt64()
+			}
+		}
+		if !model.Params.Mirostat.IsNull() {
+			apiModel.Params.Mirostat = model.Params.Mirostat.ValueInt64Pointer()
+		}
+		if !model.Params.MirostatEta.IsNull() {
+			apiModel.Params.MirostatEta = model.Params.MirostatEta.ValueFloat64Pointer()
+		}
+		if !model.Params.MirostatTau.IsNull() {
+			apiModel.Params.MirostatTau = model.Params.MirostatTau.ValueFloat64Pointer()
+		}
+		if !model.Params.NumGPU.IsNull() {
+			apiModel.Params.NumGPU = model.Params.NumGPU.ValueInt64Pointer()
+		}
+		if !model.Params.NumThread.IsNull() {
+			apiModel.Params.NumThread = model.Params.NumThread.ValueInt64Pointer()
+		}
+		if !model.Params.RepeatPenalty.IsNull() {
+			apiModel.Params.RepeatPenalty = model.Params.RepeatPenalty.ValueFloat64Pointer()
+		}
+		if !model.Params.TfsZ.IsNull() {
+			apiModel.Params.TfsZ = model.Params.TfsZ.ValueFloat64Pointer()
+		}
+		if !model.Params.UseMmap.IsNull() {
+			apiModel.Params.UseMmap = model.Params.UseMmap.ValueBoolPointer()
+		}
+		if !model.Params.UseMlock.IsNull() {
+			apiModel.Params.UseMlock = model.Params.UseMlock.ValueBoolPointer()
+		}
+		if !model.Params.PresencePenalty.IsNull() {
+			apiModel.Params.PresencePenalty = model.Params.PresencePenalty.ValueFloat64Pointer()
+		}
+	}
+
+	// Handle Meta
+	if model.Meta != nil {
+		apiModel.Meta = &APIModelMeta{}
+		if !model.Meta.ProfileImageURL.IsNull() {
+			apiModel.Meta.ProfileImageURL = model.Meta.ProfileImageURL.ValueString()
+		}
+		if !model.Meta.Description.IsNull() {
+			apiModel.Meta.Description = model.Meta.Description.ValueString()
+		}
+
+		if model.Meta.Capabilities != nil {
+			apiModel.Meta.Capabilities = &APIModelCapabilities{
+				Vision:          model.Meta.Capabilities.Vision.ValueBool(),
+				Usage:           model.Meta.Capabilities.Usage.ValueBool(),
+				Citations:       model.Meta.Capabilities.Citations.ValueBool(),
+				WebSearch:       model.Meta.Capabilities.WebSearch.ValueBool(),
+				ImageGeneration: model.Meta.Capabilities.ImageGeneration.ValueBool(),
+				CodeInterpreter: model.Meta.Capabilities.CodeInterpreter.ValueBool(),
+				FileUpload:      model.Meta.Capabilities.FileUpload.ValueBool(),
+			}
+		}
+
+		if len(model.Meta.Tags) > 0 {
+			apiModel.Meta.Tags = make([]APITag, len(model.Meta.Tags))
+			for i, tag := range model.Meta.Tags {
+				if !tag.Name.IsNull() {
+					apiModel.Meta.Tags[i] = APITag{
+						Name: tag.Name.ValueString(),
+					}
+				}
+			}
+		}
+
+		if model.Meta.DefaultFeatures != nil {
+			ids := []string{}
+			if model.Meta.DefaultFeatures.WebSearch.ValueBool() {
+				ids = append(ids, featureWebSearch)
+			}
+			if model.Meta.DefaultFeatures.ImageGeneration.ValueBool() {
+				ids = append(ids, featureImageGeneration)
+			}
+			if model.Meta.DefaultFeatures.CodeInterpreter.ValueBool() {
+				ids = append(ids, featureCodeInterpreter)
+			}
+			apiModel.Meta.DefaultFeatureIDs = &ids
+		}
+
+		if len(model.Meta.SuggestionPrompts) > 0 {
+			apiModel.Meta.SuggestionPrompts = make([]APISuggestionPrompt, len(model.Meta.SuggestionPrompts))
+			for i, prompt := range model.Meta.SuggestionPrompts {
+				apiModel.Meta.SuggestionPrompts[i] = APISuggestionPrompt{
+					Content: prompt.Content.ValueString(),
+				}
+				for _, title := range prompt.Title {
+					apiModel.Meta.SuggestionPrompts[i].Title = append(apiModel.Meta.SuggestionPrompts[i].Title, title.ValueString())
+				}
+			}
+		}
+
+		if len(model.Meta.FilterIDs) > 0 {
+			apiModel.Meta.FilterIDs = make([]string, len(model.Meta.FilterIDs))
+			for i, id := range model.Meta.FilterIDs {
+				if !id.IsNull() {
+					apiModel.Meta.FilterIDs[i] = id.ValueString()
+				}
+			}
+		}
+
+		if len(model.Meta.ToolIDs) > 0 {
+			apiModel.Meta.ToolIDs = make([]string, len(model.Meta.ToolIDs))
+			for i, id := range model.Meta.ToolIDs {
+				if !id.IsNull() {
+					apiModel.Meta.ToolIDs[i] = id.ValueString()
+				}
+			}
+		}
+
+		for _, id := range model.Meta.KnowledgeIDs {
+			if !id.IsNull() {
+				apiModel.Meta.Knowledge = append(apiModel.Meta.Knowledge, APIModelKnowledge{
+					ID:   id.ValueString(),
+					Type: knowledgeTypeCollection,
+				})
+			}
+		}
+	}
+
+	// Extra meta fields are validated to be a JSON object by the resource schema
+	if !model.MetaJSON.IsNull() && !model.MetaJSON.IsUnknown() {
+		var extra map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(model.MetaJSON.ValueString()), &extra); err == nil && len(extra) > 0 {
+			if apiModel.Meta == nil {
+				apiModel.Meta = &APIModelMeta{}
+			}
+			apiModel.Meta.Extra = extra
+		}
+	}
+
+	// Handle AccessControl
+	if model.AccessControl != nil {
+		apiModel.AccessControl = &APIAccessControl{}
+		if model.AccessControl.Read != nil {
+			apiModel.AccessControl.Read = &APIAccessGroup{
+				GroupIDs: make([]string, 0),
+				UserIDs:  make([]string, 0),
+			}
+			for _, id := range model.AccessControl.Read.GroupIDs {
+				if !id.IsNull() {
+					apiModel.AccessControl.Read.GroupIDs = append(apiModel.AccessControl.Read.GroupIDs, id.ValueString())
+				}
+			}
+			for _, id := range model.AccessControl.Read.UserIDs {
+				if !id.IsNull() {
+					apiModel.AccessControl.Read.UserIDs = append(apiModel.AccessControl.Read.UserIDs, id.ValueString())
+				}
+			}
+		}
+		if model.AccessControl.Write != nil {
+			apiModel.AccessControl.Write = &APIAccessGroup{
+				GroupIDs: make([]string, 0),
+				UserIDs:  make([]string, 0),
+			}
+			for _, id := range model.AccessControl.Write.GroupIDs {
+				if !id.IsNull() {
+					apiModel.AccessControl.Write.GroupIDs = append(apiModel.AccessControl.Write.GroupIDs, id.ValueString())
+				}
+			}
+			for _, id := range model.AccessControl.Write.UserIDs {
+				if !id.IsNull() {
+					apiModel.AccessControl.Write.UserIDs = append(apiModel.AccessControl.Write.UserIDs, id.ValueString())
+				}
+			}
+		}
+	}
+
+	return apiModel
+}
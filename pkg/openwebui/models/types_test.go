@@ -0,0 +1,183 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package models
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestLogitBiasMarshalJSON(t *testing.T) {
+	payload, err := json.Marshal(LogitBias{"413": -100, "5432": 50})
+	if err != nil {
+		t.Fatalf("marshaling logit bias: %v", err)
+	}
+
+	if want := `"413:-100,5432:50"`; string(payload) != want {
+		t.Errorf("got %s, want %s", payload, want)
+	}
+}
+
+func TestLogitBiasUnmarshalJSON(t *testing.T) {
+	want := LogitBias{"413": -100, "5432": 50}
+
+	for name, input := range map[string]string{
+		"string": `"5432:50, 413:-100"`,
+		"object": `{"5432": 50, "413": -100}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			var got LogitBias
+			if err := json.Unmarshal([]byte(input), &got); err != nil {
+				t.Fatalf("unmarshaling logit bias: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestLogitBiasUnmarshalJSONInvalidPair(t *testing.T) {
+	var got LogitBias
+	if err := json.Unmarshal([]byte(`"5432"`), &got); err == nil {
+		t.Error("expected an error for a pair without a bias")
+	}
+}
+
+func TestAPIModelMetaKeepsExtraFields(t *testing.T) {
+	input := `{"description":"Assistant","position":3,"hidden":{"sidebar":true}}`
+
+	var meta APIModelMeta
+	if err := json.Unmarshal([]byte(input), &meta); err != nil {
+		t.Fatalf("unmarshaling meta: %v", err)
+	}
+	if meta.Description != "Assistant" {
+		t.Errorf("got description %q, want %q", meta.Description, "Assistant")
+	}
+	if len(meta.Extra) != 2 {
+		t.Fatalf("got extra fields %v, want position and hidden", meta.Extra)
+	}
+
+	payload, err := json.Marshal(meta)
+	if err != nil {
+		t.Fatalf("marshaling meta: %v", err)
+	}
+	got, err := NormalizeJSON(payload)
+	if err != nil {
+		t.Fatalf("normalizing meta: %v", err)
+	}
+	want, _ := NormalizeJSON([]byte(input))
+	if string(got) != string(want) {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestModelMetaJSONRoundTrip(t *testing.T) {
+	model := APIToModel(&APIModel{
+		ID:   "assistant",
+		Meta: &APIModelMeta{Extra: map[string]json.RawMessage{"position": json.RawMessage(`3`)}},
+	})
+	if got := model.MetaJSON.ValueString(); got != `{"position":3}` {
+		t.Errorf("got meta_json %s, want %s", got, `{"position":3}`)
+	}
+
+	apiModel := ModelToAPI(model)
+	if got := string(apiModel.Meta.Extra["position"]); got != "3" {
+		t.Errorf("got position %s, want 3", got)
+	}
+}
+
+func TestKeepEmptyCollections(t *testing.T) {
+	prior := &Model{
+		Params: &ModelParams{Stop: []types.String{}},
+		Meta:   &ModelMeta{Tags: []Tag{}, ToolIDs: []types.String{}},
+	}
+	model := APIToModel(&APIModel{
+		Params: &APIModelParams{},
+		Meta:   &APIModelMeta{Tags: []APITag{}, FilterIDs: []string{"filter"}},
+	})
+
+	model.KeepEmptyCollections(prior)
+
+	if model.Params.Stop == nil || len(model.Params.Stop) != 0 {
+		t.Errorf("got stop %v, want an empty list", model.Params.Stop)
+	}
+	if model.Meta.Tags == nil || len(model.Meta.Tags) != 0 {
+		t.Errorf("got tags %v, want an empty list", model.Meta.Tags)
+	}
+	if model.Meta.ToolIDs == nil || len(model.Meta.ToolIDs) != 0 {
+		t.Errorf("got tool IDs %v, want an empty set", model.Meta.ToolIDs)
+	}
+	if len(model.Meta.FilterIDs) != 1 {
+		t.Errorf("got filter IDs %v, want the server value", model.Meta.FilterIDs)
+	}
+	if model.Meta.KnowledgeIDs != nil {
+		t.Errorf("got knowledge IDs %v, want null", model.Meta.KnowledgeIDs)
+	}
+}
+
+func TestModelCapabilitiesRoundTrip(t *testing.T) {
+	// Unset capabilities are planned as false, so they must read back as false
+	want := &ModelCapabilities{
+		Vision:          types.BoolValue(true),
+		Usage:           types.BoolValue(false),
+		Citations:       types.BoolValue(true),
+		WebSearch:       types.BoolValue(false),
+		ImageGeneration: types.BoolValue(false),
+		CodeInterpreter: types.BoolValue(true),
+		FileUpload:      types.BoolValue(false),
+	}
+
+	payload, err := json.Marshal(ModelToAPI(&Model{Meta: &ModelMeta{Capabilities: want}}))
+	if err != nil {
+		t.Fatalf("marshaling model: %v", err)
+	}
+	var apiModel APIModel
+	if err := json.Unmarshal(payload, &apiModel); err != nil {
+		t.Fatalf("unmarshaling model: %v", err)
+	}
+
+	got := APIToModel(&apiModel).Meta.Capabilities
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got capabilities %+v, want %+v", got, want)
+	}
+}
+
+func TestModelParamsZeroRoundTrip(t *testing.T) {
+	// 0 is a valid value for these params and must not be omitted or read back as null
+	want := &ModelParams{
+		MirostatEta:   types.Float64Value(0),
+		MirostatTau:   types.Float64Value(0),
+		NumThread:     types.Int64Value(0),
+		RepeatPenalty: types.Float64Value(0),
+		TfsZ:          types.Float64Value(0),
+
+		FrequencyPenalty: types.Float64Value(0),
+		PresencePenalty:  types.Float64Value(0),
+
+		// Lower bounds of the sampling params
+		Temperature: types.Float64Value(0),
+		TopP:        types.Float64Value(0),
+		MinP:        types.Float64Value(0),
+		TopK:        types.Int64Value(0),
+		RepeatLastN: types.Int64Value(0),
+	}
+
+	payload, err := json.Marshal(ModelToAPI(&Model{Params: want}))
+	if err != nil {
+		t.Fatalf("marshaling model: %v", err)
+	}
+	var apiModel APIModel
+	if err := json.Unmarshal(payload, &apiModel); err != nil {
+		t.Fatalf("unmarshaling model: %v", err)
+	}
+
+	got := APIToModel(&apiModel).Params
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got params %+v, want %+v", got, want)
+	}
+}
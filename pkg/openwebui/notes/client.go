@@ -0,0 +1,58 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package notes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/internal/rest"
+)
+
+// Client implements the note operations
+type Client struct {
+	api *rest.Client
+}
+
+// NewClient creates a new notes client
+func NewClient(endpoint, token string, httpClient *http.Client) *Client {
+	return &Client{api: rest.New(endpoint, token, httpClient)}
+}
+
+// Create creates a new note owned by the authenticated user
+func (c *Client) Create(ctx context.Context, form *NoteForm) (*Note, error) {
+	var result Note
+	if err := c.api.Do(ctx, "POST", "/api/v1/notes/create", form, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Get gets a note by ID
+func (c *Client) Get(ctx context.Context, id string) (*Note, error) {
+	var result Note
+	if err := c.api.Do(ctx, "GET", fmt.Sprintf("/api/v1/notes/%s", url.PathEscape(id)), nil, &result); err != nil {
+		return nil, fmt.Errorf("note %s: %w", id, err)
+	}
+	return &result, nil
+}
+
+// Update updates an existing note
+func (c *Client) Update(ctx context.Context, id string, form *NoteForm) (*Note, error) {
+	var result Note
+	if err := c.api.Do(ctx, "POST", fmt.Sprintf("/api/v1/notes/%s/update", url.PathEscape(id)), form, &result); err != nil {
+		return nil, fmt.Errorf("note %s: %w", id, err)
+	}
+	return &result, nil
+}
+
+// Delete deletes a note
+func (c *Client) Delete(ctx context.Context, id string) error {
+	if err := c.api.Do(ctx, "DELETE", fmt.Sprintf("/api/v1/notes/%s/delete", url.PathEscape(id)), nil, nil); err != nil {
+		return fmt.Errorf("note %s: %w", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package notes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+)
+
+func TestUpdate(t *testing.T) {
+	var form NoteForm
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v1/notes/n1/update" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&form)
+		_ = json.NewEncoder(w).Encode(Note{ID: "n1", UserID: "u1", Title: form.Title, Data: form.Data})
+	}))
+	defer ts.Close()
+
+	note, err := NewClient(ts.URL, "token", ts.Client()).Update(context.Background(), "n1", &NoteForm{
+		Title: "Runbook",
+		Data:  &NoteData{Content: NoteContent{Markdown: "# Runbook"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if note.Title != "Runbook" || note.Data == nil || note.Data.Content.Markdown != "# Runbook" {
+		t.Errorf("note = %+v", note)
+	}
+}
+
+func TestGetNotFound(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"detail":"We could not find what you're looking for :/"}`))
+	}))
+	defer ts.Close()
+
+	_, err := NewClient(ts.URL, "token", ts.Client()).Get(context.Background(), "missing")
+	if !errors.Is(err, apierror.ErrNotFound) {
+		t.Errorf("error = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package notes
+
+// Note represents a note of the notes workspace
+type Note struct {
+	ID            string         `json:"id"`
+	UserID        string         `json:"user_id"`
+	Title         string         `json:"title"`
+	Data          *NoteData      `json:"data"`
+	AccessControl *AccessControl `json:"access_control"`
+	CreatedAt     int64          `json:"created_at"`
+	UpdatedAt     int64          `json:"updated_at"`
+}
+
+// NoteData holds the content of a note
+type NoteData struct {
+	Content NoteContent `json:"content"`
+}
+
+// NoteContent holds the content of a note in the formats of the note editor.
+// Only the markdown is set by the provider, the editor fills in the others.
+type NoteContent struct {
+	JSON     interface{} `json:"json"`
+	HTML     *string     `json:"html"`
+	Markdown string      `json:"md"`
+}
+
+// NoteForm represents the payload for creating or updating a note
+type NoteForm struct {
+	Title string    `json:"title"`
+	Data  *NoteData `json:"data"`
+	// AccessControl is sent as null to make the note public
+	AccessControl *AccessControl `json:"access_control"`
+}
+
+// AccessControl represents the users and groups allowed to access a private note
+type AccessControl struct {
+	Read  AccessGroup `json:"read"`
+	Write AccessGroup `json:"write"`
+}
+
+// AccessGroup represents the users and groups granted a single permission
+type AccessGroup struct {
+	GroupIDs []string `json:"group_ids"`
+	UserIDs  []string `json:"user_ids"`
+}
@@ -0,0 +1,120 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+// Package openwebui is a Go client for the OpenWebUI API.
+//
+// Each part of the API is served by its own package, e.g. models or knowledge.
+// Client bundles all of them behind a single endpoint, token and HTTP client:
+//
+//	client, err := openwebui.NewClient("https://chat.example.com", token, transport.Config{})
+//	if err != nil {
+//		return err
+//	}
+//	model, err := client.Models.GetModel(ctx, "gpt-4o-custom")
+//
+// Failed API calls return an *apierror.APIError, which can be classified with
+// errors.Is and apierror.ErrNotFound, apierror.ErrForbidden and so on.
+package openwebui
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/auths"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/channels"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/chats"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/configs"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/evaluations"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/files"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/folders"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/functions"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/groups"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/knowledge"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/memories"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/models"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/notes"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/pipelines"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/system"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/tools"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/transport"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/users"
+)
+
+// Client gives access to all parts of the OpenWebUI API
+type Client struct {
+	Auths       *auths.Client
+	Channels    *channels.Client
+	Chats       *chats.Client
+	Configs     *configs.Client
+	Evaluations *evaluations.Client
+	Files       *files.Client
+	Folders     *folders.Client
+	Functions   *functions.Client
+	Groups      *groups.Client
+	Knowledge   *knowledge.Client
+	Memories    *memories.Client
+	Models      *models.Client
+	Notes       *notes.Client
+	Pipelines   *pipelines.Client
+	System      *system.Client
+	Tools       *tools.Client
+	Users       *users.Client
+
+	endpoint   string
+	httpClient *http.Client
+}
+
+// New creates a client that sends its requests with httpClient. Use NewClient
+// to get an HTTP client with retries, logging and the other transport settings.
+func New(endpoint, token string, httpClient *http.Client) *Client {
+	endpoint = strings.TrimRight(endpoint, "/")
+	return &Client{
+		Auths:       auths.NewClient(endpoint, token, httpClient),
+		Channels:    channels.NewClient(endpoint, token, httpClient),
+		Chats:       chats.NewClient(endpoint, token, httpClient),
+		Configs:     configs.NewClient(endpoint, token, httpClient),
+		Evaluations: evaluations.NewClient(endpoint, token, httpClient),
+		Files:       files.NewClient(endpoint, token, httpClient),
+		Folders:     folders.NewClient(endpoint, token, httpClient),
+		Functions:   functions.NewClient(endpoint, token, httpClient),
+		Groups:      groups.NewClient(endpoint, token, httpClient),
+		Knowledge:   knowledge.NewClient(endpoint, token, httpClient),
+		Memories:    memories.NewClient(endpoint, token, httpClient),
+		Models:      models.NewClient(endpoint, token, httpClient),
+		Notes:       notes.NewClient(endpoint, token, httpClient),
+		Pipelines:   pipelines.NewClient(endpoint, token, httpClient),
+		System:      system.NewClient(endpoint, token, httpClient),
+		Tools:       tools.NewClient(endpoint, token, httpClient),
+		Users:       users.NewClient(endpoint, token, httpClient),
+		endpoint:    endpoint,
+		httpClient:  httpClient,
+	}
+}
+
+// NewClient creates a client with an HTTP client built from cfg. Zero values
+// in cfg disable the corresponding feature, e.g. a MaxRetries of 0 disables retries.
+func NewClient(endpoint, token string, cfg transport.Config) (*Client, error) {
+	httpClient, err := transport.NewClient(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error creating HTTP client: %v", err)
+	}
+	return New(endpoint, token, httpClient), nil
+}
+
+// Signin signs in with an email and password and returns a client that uses
+// the resulting session token, sharing the HTTP client of c.
+func (c *Client) Signin(ctx context.Context, email, password string) (*Client, error) {
+	session, err := c.Auths.Signin(ctx, email, password)
+	if err != nil {
+		return nil, err
+	}
+	return c.WithToken(session.Token), nil
+}
+
+// WithToken returns a client that authenticates with token, e.g. an API key of
+// another user, sharing the HTTP client of c.
+func (c *Client) WithToken(token string) *Client {
+	return New(c.endpoint, token, c.httpClient)
+}
@@ -0,0 +1,68 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package openwebui
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/transport"
+)
+
+func TestClientSignin(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/auths/signin":
+			var form map[string]string
+			_ = json.NewDecoder(r.Body).Decode(&form)
+			if form["email"] != "ci@example.com" || form["password"] != "secret" {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"detail":"The email or password provided is incorrect."}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"id":"u1","email":"ci@example.com","token":"session-token"}`))
+		case "/api/v1/auths/":
+			if r.Header.Get("Authorization") != "Bearer session-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"detail":"Not authenticated"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"id":"u1","email":"ci@example.com","role":"admin"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	// A trailing slash on the endpoint must not end up in the request paths
+	client, err := NewClient(ts.URL+"/", "", transport.Config{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := client.Auths.GetSessionUser(context.Background()); !errors.Is(err, apierror.ErrUnauthorized) {
+		t.Errorf("GetSessionUser() without token error = %v, want ErrUnauthorized", err)
+	}
+
+	if _, err := client.Signin(context.Background(), "ci@example.com", "wrong"); err == nil {
+		t.Error("Signin() with a wrong password succeeded")
+	}
+
+	signedIn, err := client.Signin(context.Background(), "ci@example.com", "secret")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	user, err := signedIn.Auths.GetSessionUser(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user.Role != "admin" {
+		t.Errorf("Role = %q, want %q", user.Role, "admin")
+	}
+}
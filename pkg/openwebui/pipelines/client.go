@@ -0,0 +1,170 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package pipelines
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/internal/rest"
+)
+
+// Client implements the operations on Pipelines servers, which OpenWebUI
+// proxies for admins
+type Client struct {
+	// endpoint, token and httpClient send the multipart uploads, which the
+	// JSON client does not handle
+	endpoint   string
+	token      string
+	httpClient *http.Client
+	api        *rest.Client
+}
+
+// NewClient creates a new pipelines client
+func NewClient(endpoint, token string, httpClient *http.Client) *Client {
+	return &Client{
+		endpoint:   endpoint,
+		token:      token,
+		httpClient: httpClient,
+		api:        rest.New(endpoint, token, httpClient),
+	}
+}
+
+// ListConnections gets the connections served by a Pipelines server
+func (c *Client) ListConnections(ctx context.Context) ([]Connection, error) {
+	var result struct {
+		Data []Connection `json:"data"`
+	}
+	if err := c.api.Do(ctx, "GET", "/api/v1/pipelines/list", nil, &result); err != nil {
+		return nil, err
+	}
+	return result.Data, nil
+}
+
+// FindConnection gets the index of the connection with the given URL
+func (c *Client) FindConnection(ctx context.Context, serverURL string) (int, error) {
+	connections, err := c.ListConnections(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, connection := range connections {
+		if strings.TrimRight(connection.URL, "/") == strings.TrimRight(serverURL, "/") {
+			return connection.Idx, nil
+		}
+	}
+	return 0, fmt.Errorf("pipelines connection %s: %w", serverURL, apierror.ErrNotFound)
+}
+
+// List gets the pipelines loaded by the server of connection urlIdx
+func (c *Client) List(ctx context.Context, urlIdx int) ([]Pipeline, error) {
+	var result struct {
+		Data []Pipeline `json:"data"`
+	}
+	if err := c.api.Do(ctx, "GET", "/api/v1/pipelines/?urlIdx="+strconv.Itoa(urlIdx), nil, &result); err != nil {
+		return nil, err
+	}
+	return result.Data, nil
+}
+
+// Get gets a pipeline loaded by the server of connection urlIdx
+func (c *Client) Get(ctx context.Context, urlIdx int, id string) (*Pipeline, error) {
+	pipelines, err := c.List(ctx, urlIdx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pipeline := range pipelines {
+		if pipeline.ID == id {
+			return &pipeline, nil
+		}
+	}
+	return nil, fmt.Errorf("pipeline %s: %w", id, apierror.ErrNotFound)
+}
+
+// Upload uploads a pipeline to the server of connection urlIdx, replacing the
+// pipeline with the same file name. The ID of the pipeline is the file name
+// without the .py extension.
+func (c *Client) Upload(ctx context.Context, urlIdx int, filename string, content io.Reader) error {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	if err := writer.WriteField("urlIdx", strconv.Itoa(urlIdx)); err != nil {
+		return fmt.Errorf("error writing form field: %v", err)
+	}
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		return fmt.Errorf("error creating form file: %v", err)
+	}
+	if _, err := io.Copy(part, content); err != nil {
+		return fmt.Errorf("error writing form file: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("error closing multipart writer: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/v1/pipelines/upload", c.endpoint), body)
+	if err != nil {
+		return fmt.Errorf("error creating request: %v", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return apierror.FromResponse(resp)
+	}
+
+	return nil
+}
+
+// Delete deletes a pipeline from the server of connection urlIdx
+func (c *Client) Delete(ctx context.Context, urlIdx int, id string) error {
+	form := map[string]interface{}{"id": id, "urlIdx": urlIdx}
+	return c.api.Do(ctx, "DELETE", "/api/v1/pipelines/delete", form, nil)
+}
+
+// GetValves gets the valve values of a pipeline
+func (c *Client) GetValves(ctx context.Context, urlIdx int, id string) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	if err := c.api.Do(ctx, "GET", fmt.Sprintf("/api/v1/pipelines/%s/valves?urlIdx=%d", url.PathEscape(id), urlIdx), nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateValves changes the valve values of a pipeline. Valves that are not
+// in valves keep their current value.
+func (c *Client) UpdateValves(ctx context.Context, urlIdx int, id string, valves map[string]interface{}) (map[string]interface{}, error) {
+	current, err := c.GetValves(ctx, urlIdx, id)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		current = make(map[string]interface{}, len(valves))
+	}
+	for key, value := range valves {
+		current[key] = value
+	}
+
+	var result map[string]interface{}
+	if err := c.api.Do(ctx, "POST", fmt.Sprintf("/api/v1/pipelines/%s/valves/update?urlIdx=%d", url.PathEscape(id), urlIdx), current, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
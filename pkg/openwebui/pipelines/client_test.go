@@ -0,0 +1,82 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package pipelines
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestUploadSendsConnectionIndex(t *testing.T) {
+	var urlIdx, filename, content string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/pipelines/list":
+			_, _ = w.Write([]byte(`{"data":[{"url":"http://pipelines:9099","idx":2}]}`))
+		case "/api/v1/pipelines/upload":
+			urlIdx = r.FormValue("urlIdx")
+			file, header, err := r.FormFile("file")
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			b, _ := io.ReadAll(file)
+			filename, content = header.Filename, string(b)
+			_, _ = w.Write([]byte(`{"status":true}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(ts.URL, "token", ts.Client())
+	ctx := context.Background()
+
+	idx, err := client.FindConnection(ctx, "http://pipelines:9099/")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := client.Upload(ctx, idx, "rate_limit.py", strings.NewReader("class Pipeline: pass")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if urlIdx != "2" || filename != "rate_limit.py" || content != "class Pipeline: pass" {
+		t.Errorf("uploaded urlIdx=%q filename=%q content=%q", urlIdx, filename, content)
+	}
+}
+
+func TestUpdateValvesKeepsOtherValves(t *testing.T) {
+	stored := map[string]interface{}{"requests_per_minute": 10.0, "pipelines": []interface{}{"*"}}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("urlIdx") != "0" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/v1/pipelines/rate_limit/valves":
+		case "/api/v1/pipelines/rate_limit/valves/update":
+			stored = nil
+			_ = json.NewDecoder(r.Body).Decode(&stored)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(stored)
+	}))
+	defer ts.Close()
+
+	valves, err := NewClient(ts.URL, "token", ts.Client()).UpdateValves(context.Background(), 0, "rate_limit", map[string]interface{}{"requests_per_minute": 60})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if valves["requests_per_minute"] != 60.0 || stored["pipelines"] == nil {
+		t.Errorf("valves = %v, want requests_per_minute changed and pipelines kept", valves)
+	}
+}
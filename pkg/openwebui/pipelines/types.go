@@ -0,0 +1,22 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package pipelines
+
+// Connection represents an OpenAI API connection served by a Pipelines server.
+// Idx is the position of the connection, which identifies the server in the
+// other pipeline operations.
+type Connection struct {
+	URL string `json:"url"`
+	Idx int    `json:"idx"`
+}
+
+// Pipeline represents a pipeline loaded by a Pipelines server
+type Pipeline struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Type is "pipe", "manifold" or "filter"
+	Type string `json:"type"`
+	// Valves reports whether the pipeline has valves
+	Valves bool `json:"valves"`
+}
@@ -0,0 +1,33 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package system
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/internal/rest"
+)
+
+// Client implements the operations on the OpenWebUI server itself
+type Client struct {
+	// anonymous sends requests without the token, as the server endpoints do not require it
+	anonymous *rest.Client
+}
+
+// NewClient creates a new system client
+func NewClient(endpoint, token string, httpClient *http.Client) *Client {
+	return &Client{
+		anonymous: rest.New(endpoint, "", httpClient),
+	}
+}
+
+// GetVersion gets the version of the OpenWebUI server. The endpoint does not require authentication.
+func (c *Client) GetVersion(ctx context.Context) (*Version, error) {
+	var result Version
+	if err := c.anonymous.Do(ctx, "GET", "/api/version", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
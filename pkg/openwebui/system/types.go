@@ -0,0 +1,79 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package system
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Version represents the version reported by the OpenWebUI server
+type Version struct {
+	Version string `json:"version"`
+}
+
+// Parts returns the major, minor and patch components of the version. ok is
+// false when the version cannot be parsed, e.g. for development builds.
+func (v *Version) Parts() (major, minor, patch int, ok bool) {
+	parts, ok := parseVersion(v.Version)
+	return parts[0], parts[1], parts[2], ok
+}
+
+// Build returns the pre-release and build metadata of the version, e.g. "dev"
+// and "a1b2c3d" for "0.6.15-dev+a1b2c3d". Both are empty for releases.
+func (v *Version) Build() (prerelease, build string) {
+	s := strings.TrimPrefix(v.Version, "v")
+	if i := strings.IndexByte(s, '+'); i >= 0 {
+		build = s[i+1:]
+		s = s[:i]
+	}
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		prerelease = s[i+1:]
+	}
+	return prerelease, build
+}
+
+// AtLeast reports whether the server version is minimum or newer. Versions that
+// cannot be parsed, e.g. those of development builds, are assumed to be new enough.
+func (v *Version) AtLeast(minimum string) bool {
+	current, ok := parseVersion(v.Version)
+	if !ok {
+		return true
+	}
+	required, ok := parseVersion(minimum)
+	if !ok {
+		return true
+	}
+
+	for i := range current {
+		if current[i] != required[i] {
+			return current[i] > required[i]
+		}
+	}
+	return true
+}
+
+// parseVersion parses a "major.minor.patch" version, ignoring a leading "v" and
+// any pre-release or build suffix
+func parseVersion(s string) ([3]int, bool) {
+	var parts [3]int
+
+	s = strings.TrimPrefix(s, "v")
+	if i := strings.IndexAny(s, "-+ "); i >= 0 {
+		s = s[:i]
+	}
+
+	fields := strings.Split(s, ".")
+	if len(fields) == 0 || len(fields) > 3 {
+		return parts, false
+	}
+	for i, field := range fields {
+		n, err := strconv.Atoi(field)
+		if err != nil || n < 0 {
+			return parts, false
+		}
+		parts[i] = n
+	}
+	return parts, true
+}
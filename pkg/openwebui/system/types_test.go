@@ -0,0 +1,61 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package system
+
+import "testing"
+
+func TestVersionAtLeast(t *testing.T) {
+	tests := []struct {
+		version string
+		minimum string
+		want    bool
+	}{
+		{version: "0.6.5", minimum: "0.6.5", want: true},
+		{version: "0.6.10", minimum: "0.6.9", want: true},
+		{version: "0.6.5", minimum: "0.6.6", want: false},
+		{version: "0.5.20", minimum: "0.6", want: false},
+		{version: "1.0.0", minimum: "0.6.6", want: true},
+		{version: "v0.6.6", minimum: "0.6.6", want: true},
+		{version: "0.6.6-dev", minimum: "0.6.6", want: true},
+		{version: "0.6", minimum: "0.6.1", want: false},
+		{version: "main", minimum: "0.6.6", want: true},
+		{version: "", minimum: "0.6.6", want: true},
+	}
+
+	for _, tt := range tests {
+		if got := (&Version{Version: tt.version}).AtLeast(tt.minimum); got != tt.want {
+			t.Errorf("%q.AtLeast(%q) = %t, want %t", tt.version, tt.minimum, got, tt.want)
+		}
+	}
+}
+
+func TestVersionParts(t *testing.T) {
+	major, minor, patch, ok := (&Version{Version: "0.6.15-dev"}).Parts()
+	if !ok || major != 0 || minor != 6 || patch != 15 {
+		t.Errorf("Parts() = %d, %d, %d, %t, want 0, 6, 15, true", major, minor, patch, ok)
+	}
+
+	if _, _, _, ok := (&Version{Version: "main"}).Parts(); ok {
+		t.Error("Parts() of an unparsable version reported ok")
+	}
+}
+
+func TestVersionBuild(t *testing.T) {
+	tests := []struct {
+		version    string
+		prerelease string
+		build      string
+	}{
+		{version: "0.6.15"},
+		{version: "0.6.15-dev", prerelease: "dev"},
+		{version: "v0.6.15-rc.1+a1b2c3d", prerelease: "rc.1", build: "a1b2c3d"},
+		{version: "0.6.15+build-7", build: "build-7"},
+	}
+
+	for _, tt := range tests {
+		if prerelease, build := (&Version{Version: tt.version}).Build(); prerelease != tt.prerelease || build != tt.build {
+			t.Errorf("%q.Build() = %q, %q, want %q, %q", tt.version, prerelease, build, tt.prerelease, tt.build)
+		}
+	}
+}
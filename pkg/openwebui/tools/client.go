@@ -0,0 +1,96 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/internal/rest"
+)
+
+// Client implements the workspace tool operations
+type Client struct {
+	api *rest.Client
+}
+
+// NewClient creates a new tools client
+func NewClient(endpoint, token string, httpClient *http.Client) *Client {
+	return &Client{api: rest.New(endpoint, token, httpClient)}
+}
+
+// Create creates a new tool
+func (c *Client) Create(ctx context.Context, form *ToolForm) (*Tool, error) {
+	var result Tool
+	if err := c.api.Do(ctx, "POST", "/api/v1/tools/create", form, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Get gets a tool by ID
+func (c *Client) Get(ctx context.Context, id string) (*Tool, error) {
+	var result Tool
+	if err := c.api.Do(ctx, "GET", fmt.Sprintf("/api/v1/tools/id/%s", id), nil, &result); err != nil {
+		return nil, fmt.Errorf("tool %s: %w", id, err)
+	}
+	return &result, nil
+}
+
+// Update updates an existing tool
+func (c *Client) Update(ctx context.Context, id string, form *ToolForm) (*Tool, error) {
+	var result Tool
+	if err := c.api.Do(ctx, "POST", fmt.Sprintf("/api/v1/tools/id/%s/update", id), form, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Delete deletes a tool
+func (c *Client) Delete(ctx context.Context, id string) error {
+	return c.api.Do(ctx, "DELETE", fmt.Sprintf("/api/v1/tools/id/%s/delete", id), nil, nil)
+}
+
+// GetValves gets the valve values of a tool. The result is nil when the
+// valves were never set.
+func (c *Client) GetValves(ctx context.Context, id string) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	if err := c.api.Do(ctx, "GET", fmt.Sprintf("/api/v1/tools/id/%s/valves", url.PathEscape(id)), nil, &result); err != nil {
+		return nil, fmt.Errorf("tool %s: %w", id, err)
+	}
+	return result, nil
+}
+
+// GetValvesSpec gets the JSON schema of the valves of a tool. The result is
+// nil when the tool has no valves.
+func (c *Client) GetValvesSpec(ctx context.Context, id string) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	if err := c.api.Do(ctx, "GET", fmt.Sprintf("/api/v1/tools/id/%s/valves/spec", url.PathEscape(id)), nil, &result); err != nil {
+		return nil, fmt.Errorf("tool %s: %w", id, err)
+	}
+	return result, nil
+}
+
+// UpdateValves changes the valve values of a tool. Valves that are not in
+// valves keep their current value.
+func (c *Client) UpdateValves(ctx context.Context, id string, valves map[string]interface{}) (map[string]interface{}, error) {
+	current, err := c.GetValves(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		current = make(map[string]interface{}, len(valves))
+	}
+	for key, value := range valves {
+		current[key] = value
+	}
+
+	var result map[string]interface{}
+	if err := c.api.Do(ctx, "POST", fmt.Sprintf("/api/v1/tools/id/%s/valves/update", url.PathEscape(id)), current, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,77 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+)
+
+func TestCreate(t *testing.T) {
+	var form ToolForm
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v1/tools/create" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&form)
+		_, _ = w.Write([]byte(`{"id":"weather","name":"Weather","content":"class Tools: pass","specs":[{"name":"get_weather"}],"meta":{"description":null},"access_control":null}`))
+	}))
+	defer ts.Close()
+
+	tool, err := NewClient(ts.URL, "token", ts.Client()).Create(context.Background(), &ToolForm{ID: "weather", Name: "Weather", Content: "class Tools: pass"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if form.ID != "weather" || form.Content != "class Tools: pass" {
+		t.Errorf("sent form %+v", form)
+	}
+	if tool.ID != "weather" || len(tool.Specs) != 1 {
+		t.Errorf("tool = %+v", tool)
+	}
+}
+
+func TestUpdateValvesKeepsOtherValves(t *testing.T) {
+	stored := map[string]interface{}{"units": "metric", "api_key": "secret"}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/tools/id/weather/valves":
+		case "/api/v1/tools/id/weather/valves/update":
+			stored = nil
+			_ = json.NewDecoder(r.Body).Decode(&stored)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(stored)
+	}))
+	defer ts.Close()
+
+	valves, err := NewClient(ts.URL, "token", ts.Client()).UpdateValves(context.Background(), "weather", map[string]interface{}{"units": "imperial"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if valves["units"] != "imperial" || valves["api_key"] != "secret" {
+		t.Errorf("valves = %v, want units changed and api_key kept", valves)
+	}
+}
+
+func TestGetNotFound(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"detail":"We could not find what you're looking for :/"}`))
+	}))
+	defer ts.Close()
+
+	_, err := NewClient(ts.URL, "token", ts.Client()).Get(context.Background(), "missing")
+	if !errors.Is(err, apierror.ErrNotFound) {
+		t.Errorf("error = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package tools
+
+// Tool represents a workspace tool
+type Tool struct {
+	ID            string                   `json:"id"`
+	UserID        string                   `json:"user_id"`
+	Name          string                   `json:"name"`
+	Content       string                   `json:"content"`
+	Specs         []map[string]interface{} `json:"specs"`
+	Meta          ToolMeta                 `json:"meta"`
+	AccessControl *AccessControl           `json:"access_control"`
+	UpdatedAt     int64                    `json:"updated_at"`
+	CreatedAt     int64                    `json:"created_at"`
+}
+
+// ToolMeta represents the metadata of a tool
+type ToolMeta struct {
+	Description *string                `json:"description"`
+	Manifest    map[string]interface{} `json:"manifest,omitempty"`
+}
+
+// ToolForm represents the payload for creating or updating a tool
+type ToolForm struct {
+	ID      string   `json:"id"`
+	Name    string   `json:"name"`
+	Content string   `json:"content"`
+	Meta    ToolMeta `json:"meta"`
+	// AccessControl is sent as null to make the tool public
+	AccessControl *AccessControl `json:"access_control"`
+}
+
+// AccessControl represents the users and groups allowed to access a private tool
+type AccessControl struct {
+	Read  AccessGroup `json:"read"`
+	Write AccessGroup `json:"write"`
+}
+
+// AccessGroup represents the users and groups granted a single permission
+type AccessGroup struct {
+	GroupIDs []string `json:"group_ids"`
+	UserIDs  []string `json:"user_ids"`
+}
@@ -0,0 +1,126 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package transport
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// maxCachedBodySize limits the size of a single cached response body
+const maxCachedBodySize = 4 << 20
+
+// CacheTransport revalidates GET requests with the ETag or Last-Modified
+// header of an earlier response to the same URL and token. When the server
+// answers 304 Not Modified, the cached response is returned as a 200 instead.
+// Last-Modified only has a resolution of one second, so every successful
+// request with another method empties the cache.
+type CacheTransport struct {
+	Base http.RoundTripper
+
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+type cacheEntry struct {
+	header http.Header
+	body   []byte
+}
+
+func (t *CacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	if req.Method != http.MethodGet {
+		resp, err := base.RoundTrip(req)
+		if err == nil && req.Method != http.MethodHead && resp.StatusCode < 400 {
+			t.mu.Lock()
+			t.entries = nil
+			t.mu.Unlock()
+		}
+		return resp, err
+	}
+
+	// Different tokens may see different objects under the same URL
+	key := req.URL.String() + " " + req.Header.Get("Authorization")
+
+	t.mu.Lock()
+	entry := t.entries[key]
+	t.mu.Unlock()
+
+	if entry != nil && req.Header.Get("If-None-Match") == "" && req.Header.Get("If-Modified-Since") == "" {
+		req = req.Clone(req.Context())
+		if etag := entry.header.Get("ETag"); etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		if modified := entry.header.Get("Last-Modified"); modified != "" {
+			req.Header.Set("If-Modified-Since", modified)
+		}
+	}
+
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if entry != nil && resp.StatusCode == http.StatusNotModified {
+		resp.Body.Close()
+		return &http.Response{
+			Status:        "200 OK",
+			StatusCode:    http.StatusOK,
+			Proto:         resp.Proto,
+			ProtoMajor:    resp.ProtoMajor,
+			ProtoMinor:    resp.ProtoMinor,
+			Header:        entry.header.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(entry.body)),
+			ContentLength: int64(len(entry.body)),
+			Request:       req,
+		}, nil
+	}
+
+	if !cacheable(resp) {
+		return resp, nil
+	}
+
+	// Read one byte past the limit to find out whether the body fits
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxCachedBodySize+1))
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	if len(body) > maxCachedBodySize {
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+		return resp, nil
+	}
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	t.mu.Lock()
+	if t.entries == nil {
+		t.entries = make(map[string]*cacheEntry)
+	}
+	t.entries[key] = &cacheEntry{header: resp.Header.Clone(), body: body}
+	t.mu.Unlock()
+
+	return resp, nil
+}
+
+// cacheable reports whether resp carries a validator and may be stored
+func cacheable(resp *http.Response) bool {
+	if resp.StatusCode != http.StatusOK || resp.ContentLength > maxCachedBodySize {
+		return false
+	}
+	if strings.Contains(resp.Header.Get("Cache-Control"), "no-store") {
+		return false
+	}
+	return resp.Header.Get("ETag") != "" || resp.Header.Get("Last-Modified") != ""
+}
@@ -0,0 +1,74 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package transport
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCacheTransport(t *testing.T) {
+	var fullResponses, notModified int
+	body := `{"id":"m1"}`
+	etag := `"v1"`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			body, etag = `{"id":"m1","name":"updated"}`, `"v2"`
+			return
+		}
+		if r.Header.Get("If-None-Match") == etag {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		fullResponses++
+		w.Header().Set("ETag", etag)
+		_, _ = w.Write([]byte(body))
+	}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: &CacheTransport{}}
+	get := func(token string) string {
+		t.Helper()
+		req, _ := http.NewRequest("GET", ts.URL+"/api/v1/models/model?id=m1", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("status = %d, want 200", resp.StatusCode)
+		}
+		got, _ := io.ReadAll(resp.Body)
+		return string(got)
+	}
+
+	for i := 0; i < 3; i++ {
+		if got := get("a"); got != `{"id":"m1"}` {
+			t.Errorf("body = %s, want the cached model", got)
+		}
+	}
+	if fullResponses != 1 || notModified != 2 {
+		t.Errorf("got %d full and %d 304 responses, want 1 and 2", fullResponses, notModified)
+	}
+
+	// Another token must not be answered from the cache of the first one
+	get("b")
+	if fullResponses != 2 {
+		t.Errorf("got %d full responses, want a separate cache entry per token", fullResponses)
+	}
+
+	resp, err := client.Post(ts.URL+"/api/v1/models/model/update?id=m1", "application/json", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if got := get("a"); got != `{"id":"m1","name":"updated"}` {
+		t.Errorf("body after update = %s, want the updated model", got)
+	}
+}
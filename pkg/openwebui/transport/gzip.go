@@ -0,0 +1,56 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package transport
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+)
+
+// gzipMinSize is the smallest request body the GzipTransport compresses.
+// Smaller bodies are not worth the extra CPU time.
+const gzipMinSize = 32 << 10
+
+// GzipTransport compresses request bodies of at least 32 KiB and marks them
+// with Content-Encoding: gzip. OpenWebUI itself does not decompress requests,
+// so this only works behind a proxy that does. Responses need no handling
+// here: http.Transport already asks for gzip and decompresses transparently.
+type GzipTransport struct {
+	Base http.RoundTripper
+}
+
+func (t *GzipTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	if req.Body == nil || req.Body == http.NoBody || req.ContentLength < gzipMinSize || req.Header.Get("Content-Encoding") != "" {
+		return base.RoundTrip(req)
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, err := io.Copy(zw, req.Body)
+	req.Body.Close()
+	if err == nil {
+		err = zw.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	compressed := buf.Bytes()
+	req = req.Clone(req.Context())
+	req.Header.Set("Content-Encoding", "gzip")
+	req.ContentLength = int64(len(compressed))
+	req.Body = io.NopCloser(bytes.NewReader(compressed))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(compressed)), nil
+	}
+
+	return base.RoundTrip(req)
+}
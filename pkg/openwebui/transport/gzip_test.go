@@ -0,0 +1,82 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package transport
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGzipTransport(t *testing.T) {
+	var encodings []string
+	var received []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		encodings = append(encodings, r.Header.Get("Content-Encoding"))
+		var body io.Reader = r.Body
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			zr, err := gzip.NewReader(r.Body)
+			if err != nil {
+				t.Errorf("invalid gzip body: %v", err)
+				return
+			}
+			body = zr
+		}
+		b, _ := io.ReadAll(body)
+		received = append(received, string(b))
+	}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: &GzipTransport{}}
+	small := `{"name":"small"}`
+	large := `{"content":"` + strings.Repeat("a", gzipMinSize) + `"}`
+	for _, body := range []string{small, large} {
+		resp, err := client.Post(ts.URL, "application/json", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	if encodings[0] != "" || encodings[1] != "gzip" {
+		t.Errorf("Content-Encoding = %q, want only the large body compressed", encodings)
+	}
+	if received[0] != small || received[1] != large {
+		t.Error("server did not receive the original bodies")
+	}
+}
+
+func TestClientDecompressesResponses(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+			t.Errorf("Accept-Encoding = %q, want gzip", r.Header.Get("Accept-Encoding"))
+		}
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		_, _ = zw.Write([]byte(`{"data":[]}`))
+		_ = zw.Close()
+		w.Header().Set("Content-Encoding", "gzip")
+		_, _ = w.Write(buf.Bytes())
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(Config{CompressRequests: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp, err := client.Get(ts.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != `{"data":[]}` {
+		t.Errorf("body = %q, want the decompressed JSON", body)
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package transport
+
+import "net/http"
+
+// HeaderTransport adds a fixed set of headers to every request, e.g. the
+// service token headers required by an access proxy in front of OpenWebUI.
+// Headers already set by the client packages are left untouched.
+type HeaderTransport struct {
+	Base    http.RoundTripper
+	Headers map[string]string
+}
+
+func (t *HeaderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	if len(t.Headers) > 0 {
+		req = req.Clone(req.Context())
+		for name, value := range t.Headers {
+			if req.Header.Get(name) == "" {
+				req.Header.Set(name, value)
+			}
+		}
+	}
+
+	return base.RoundTrip(req)
+}
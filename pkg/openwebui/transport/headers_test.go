@@ -0,0 +1,40 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package transport
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHeaderTransport(t *testing.T) {
+	var got http.Header
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header
+	}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: &HeaderTransport{Headers: map[string]string{
+		"CF-Access-Client-Id": "client-id",
+		"Authorization":       "Bearer other",
+	}}}
+	req, _ := http.NewRequest("GET", ts.URL, nil)
+	req.Header.Set("Authorization", "Bearer token")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if v := got.Get("CF-Access-Client-Id"); v != "client-id" {
+		t.Errorf("CF-Access-Client-Id = %q, want %q", v, "client-id")
+	}
+	if v := got.Get("Authorization"); v != "Bearer token" {
+		t.Errorf("Authorization = %q, want the header set by the client", v)
+	}
+	if v := req.Header.Get("CF-Access-Client-Id"); v != "" {
+		t.Errorf("original request was modified: CF-Access-Client-Id = %q", v)
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package transport
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LogSubsystem is the tflog subsystem the API calls are logged to. Its level
+// can be set separately with TF_LOG_PROVIDER_OPENWEBUI_API.
+const LogSubsystem = "api"
+
+// maxLoggedBody caps the size of the bodies written to the log
+const maxLoggedBody = 16 * 1024
+
+// redacted replaces the values of sensitive fields in logged bodies
+const redacted = "***"
+
+// LoggingTransport logs every API call with its method, URL, status code,
+// latency and JSON bodies to the logger of the request context. Credentials
+// in the bodies are redacted, and the bodies of valves are left out entirely.
+type LoggingTransport struct {
+	Base http.RoundTripper
+}
+
+func (t *LoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	ctx := tflog.NewSubsystem(req.Context(), LogSubsystem)
+	valves := valvesPath(req.URL.Path)
+
+	fields := map[string]interface{}{
+		"method": req.Method,
+		"url":    req.URL.Redacted(),
+	}
+	if req.GetBody != nil && isJSON(req.Header.Get("Content-Type")) {
+		if body, err := req.GetBody(); err == nil {
+			data, _ := io.ReadAll(body)
+			body.Close()
+			fields["request_body"] = logBody(data, valves)
+		}
+	}
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Sending API request", fields)
+
+	start := time.Now()
+	resp, err := base.RoundTrip(req)
+	fields["latency_ms"] = time.Since(start).Milliseconds()
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, LogSubsystem, "API request failed", fields)
+		return resp, err
+	}
+
+	delete(fields, "request_body")
+	fields["status"] = resp.StatusCode
+	if isJSON(resp.Header.Get("Content-Type")) {
+		data, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(data))
+		if readErr != nil {
+			return resp, readErr
+		}
+		fields["response_body"] = logBody(data, valves)
+	}
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Received API response", fields)
+
+	return resp, nil
+}
+
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"))
+}
+
+// valvesPath reports whether the path is that of the valves of a function, tool
+// or pipeline. Valve names are chosen freely by their authors, e.g. OPENAI_KEY,
+// so secrets among them cannot be told apart by name.
+func valvesPath(path string) bool {
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "valves" {
+			return true
+		}
+	}
+	return false
+}
+
+// logBody returns the body for logging. Bodies of valves are left out.
+func logBody(data []byte, valves bool) string {
+	if valves {
+		return fmt.Sprintf("(%d bytes of valves omitted)", len(data))
+	}
+	return redactBody(data)
+}
+
+// redactBody returns the body for logging, with the values of sensitive
+// fields replaced. Bodies that are not valid JSON are left out entirely, as
+// they cannot be redacted.
+func redactBody(data []byte) string {
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return fmt.Sprintf("(%d bytes omitted)", len(data))
+	}
+
+	out, err := json.Marshal(redactValue(value, false))
+	if err != nil {
+		return fmt.Sprintf("(%d bytes omitted)", len(data))
+	}
+	if len(out) > maxLoggedBody {
+		return string(out[:maxLoggedBody]) + "...(truncated)"
+	}
+	return string(out)
+}
+
+// redactValue replaces the values of sensitive fields. Within connection
+// configs, i.e. below an *_API_CONFIGS field, the plain "key" field holds the
+// API key of the connection and is redacted as well.
+func redactValue(value interface{}, inConfigs bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if sensitiveField(key) || (inConfigs && strings.EqualFold(key, "key")) {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactValue(item, inConfigs || strings.HasSuffix(strings.ToLower(key), "_api_configs"))
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item, inConfigs)
+		}
+	}
+	return value
+}
+
+// sensitiveField reports whether a JSON field holds a credential
+func sensitiveField(key string) bool {
+	key = strings.ToLower(key)
+	switch key {
+	case "password", "token", "api_key", "api_keys", "secret", "authorization":
+		return true
+	}
+	// Suffixes also match lists of credentials, e.g. OPENAI_API_KEYS, and
+	// keys of other services, e.g. BING_SEARCH_V7_SUBSCRIPTION_KEY
+	for _, suffix := range []string{"_password", "_token", "_secret", "_key", "_keys", "_auth"} {
+		if strings.HasSuffix(key, suffix) {
+			return true
+		}
+	}
+	return false
+}
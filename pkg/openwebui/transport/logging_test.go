@@ -0,0 +1,135 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package transport
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestLoggingTransport(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"u1","token":"session-token","params":{"max_tokens":100}}`))
+	}))
+	defer ts.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	client := &http.Client{Transport: &LoggingTransport{}}
+	req, _ := http.NewRequestWithContext(ctx, "POST", ts.URL+"/api/v1/auths/signin", strings.NewReader(`{"email":"a@example.com","password":"hunter2"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if !strings.Contains(string(body), "session-token") {
+		t.Errorf("response body was not passed through: %s", body)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("unable to decode log output: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("log entries = %d, want 2", len(entries))
+	}
+
+	request, response := entries[0], entries[1]
+	if request["method"] != "POST" || request["url"] != ts.URL+"/api/v1/auths/signin" {
+		t.Errorf("unexpected request entry: %v", request)
+	}
+	if got := request["request_body"]; got != `{"email":"a@example.com","password":"***"}` {
+		t.Errorf("request_body = %v", got)
+	}
+	if response["status"] != float64(http.StatusOK) {
+		t.Errorf("status = %v, want %d", response["status"], http.StatusOK)
+	}
+	if _, ok := response["latency_ms"]; !ok {
+		t.Error("response entry has no latency_ms")
+	}
+	if got := response["response_body"]; got != `{"id":"u1","params":{"max_tokens":100},"token":"***"}` {
+		t.Errorf("response_body = %v", got)
+	}
+}
+
+func TestLoggingTransportOmitsValves(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"OPENAI_KEY":"sk-valve","priority":0}`))
+	}))
+	defer ts.Close()
+
+	for _, path := range []string{
+		"/api/v1/functions/id/filter/valves/update",
+		"/api/v1/tools/id/search/valves",
+		"/api/v1/pipelines/rate_limit/valves/update",
+	} {
+		var output bytes.Buffer
+		ctx := tflogtest.RootLogger(context.Background(), &output)
+
+		client := &http.Client{Transport: &LoggingTransport{}}
+		req, _ := http.NewRequestWithContext(ctx, "POST", ts.URL+path, strings.NewReader(`{"OPENAI_KEY":"sk-valve"}`))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+
+		if strings.Contains(output.String(), "sk-valve") {
+			t.Errorf("%s: valve value was logged: %s", path, output.String())
+		}
+		if !strings.Contains(output.String(), "bytes of valves omitted") {
+			t.Errorf("%s: valves were not marked as omitted: %s", path, output.String())
+		}
+	}
+}
+
+func TestRedactBodyOmitsInvalidJSON(t *testing.T) {
+	if got := redactBody([]byte(`{"password":"hunter2"`)); got != "(21 bytes omitted)" {
+		t.Errorf("redactBody() = %q", got)
+	}
+}
+
+func TestRedactBodySensitiveFields(t *testing.T) {
+	for name, tc := range map[string]struct {
+		body string
+		want string
+	}{
+		"basic auth": {
+			body: `{"AUTOMATIC1111_API_AUTH":"user:pass","AUTOMATIC1111_BASE_URL":"http://sd:7860"}`,
+			want: `{"AUTOMATIC1111_API_AUTH":"***","AUTOMATIC1111_BASE_URL":"http://sd:7860"}`,
+		},
+		"key lists": {
+			body: `{"OPENAI_API_BASE_URLS":["https://api.openai.com/v1"],"OPENAI_API_KEYS":["sk-1"]}`,
+			want: `{"OPENAI_API_BASE_URLS":["https://api.openai.com/v1"],"OPENAI_API_KEYS":"***"}`,
+		},
+		"service keys": {
+			body: `{"BING_SEARCH_V7_SUBSCRIPTION_KEY":"bing-key","DOCUMENT_INTELLIGENCE_KEY":"di-key","DOCUMENT_INTELLIGENCE_ENDPOINT":"https://di.example.com"}`,
+			want: `{"BING_SEARCH_V7_SUBSCRIPTION_KEY":"***","DOCUMENT_INTELLIGENCE_ENDPOINT":"https://di.example.com","DOCUMENT_INTELLIGENCE_KEY":"***"}`,
+		},
+		"connection config keys": {
+			body: `{"OLLAMA_API_CONFIGS":{"0":{"enable":true,"key":"ollama-key"}},"key":"model-id"}`,
+			want: `{"OLLAMA_API_CONFIGS":{"0":{"enable":true,"key":"***"}},"key":"model-id"}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got := redactBody([]byte(tc.body)); got != tc.want {
+				t.Errorf("redactBody() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
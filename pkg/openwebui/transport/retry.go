@@ -0,0 +1,90 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package transport
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// DefaultMaxRetries is the number of retries used when the provider does not configure one
+const DefaultMaxRetries = 3
+
+// DefaultRetryWait is the initial backoff used when the provider does not configure one
+const DefaultRetryWait = time.Second
+
+// maxRetryWait caps the backoff between two attempts
+const maxRetryWait = 30 * time.Second
+
+// RetryTransport retries requests that fail with a 429, 502, 503 or 504 status code.
+// The wait between attempts doubles every time, starting at RetryWait, and is jittered
+// so that concurrent requests do not hit a rate limiter in lockstep. A Retry-After
+// header sent by the server takes precedence over the computed backoff.
+type RetryTransport struct {
+	Base       http.RoundTripper
+	MaxRetries int
+	RetryWait  time.Duration
+}
+
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := base.RoundTrip(req)
+		if err != nil || !retryable(resp.StatusCode) || attempt >= t.MaxRetries {
+			return resp, err
+		}
+
+		// Requests with a body can only be sent again if the body can be rewound
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return resp, nil
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return resp, nil
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		wait := t.backoff(attempt, resp)
+		resp.Body.Close()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (t *RetryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+		return min(time.Duration(seconds)*time.Second, maxRetryWait)
+	}
+
+	wait := t.RetryWait << attempt
+	if wait <= 0 || wait > maxRetryWait {
+		wait = maxRetryWait
+	}
+	// Jitter between half and the full backoff
+	half := wait / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+func retryable(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
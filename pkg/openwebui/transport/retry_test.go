@@ -0,0 +1,111 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package transport
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRetryTransportRetriesRetryableStatus(t *testing.T) {
+	var bodies []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: &RetryTransport{MaxRetries: 3, RetryWait: time.Millisecond}}
+	req, _ := http.NewRequest("POST", ts.URL, bytes.NewBufferString(`{"id":"a"}`))
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if len(bodies) != 3 {
+		t.Fatalf("attempts = %d, want 3", len(bodies))
+	}
+	for i, body := range bodies {
+		if body != `{"id":"a"}` {
+			t.Errorf("attempt %d body = %q", i, body)
+		}
+	}
+}
+
+func TestRetryTransportStopsAfterMaxRetries(t *testing.T) {
+	attempts := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: &RetryTransport{MaxRetries: 2, RetryWait: time.Millisecond}}
+	resp, err := client.Get(ts.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusTooManyRequests)
+	}
+	if attempts != 3 {
+		t.Errorf("attempts = %d, want 3", attempts)
+	}
+}
+
+func TestRetryTransportDoesNotRetryOtherErrors(t *testing.T) {
+	attempts := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: &RetryTransport{MaxRetries: 3, RetryWait: time.Millisecond}}
+	resp, err := client.Get(ts.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if attempts != 1 {
+		t.Errorf("attempts = %d, want 1", attempts)
+	}
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	tr := &RetryTransport{RetryWait: time.Second}
+	resp := &http.Response{Header: http.Header{}}
+
+	for attempt, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second} {
+		got := tr.backoff(attempt, resp)
+		if got < want/2 || got > want {
+			t.Errorf("attempt %d backoff = %s, want between %s and %s", attempt, got, want/2, want)
+		}
+	}
+
+	if got := tr.backoff(10, resp); got > maxRetryWait {
+		t.Errorf("backoff = %s, want at most %s", got, maxRetryWait)
+	}
+
+	resp.Header.Set("Retry-After", "5")
+	if got := tr.backoff(0, resp); got != 5*time.Second {
+		t.Errorf("Retry-After backoff = %s, want 5s", got)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+// Package transport builds the HTTP plumbing shared by the OpenWebUI client packages.
+package transport
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// DefaultTimeout is the request timeout used when the provider does not configure one
+const DefaultTimeout = 2 * time.Minute
+
+// maxIdleConnsPerHost keeps enough idle connections around for Terraform's
+// default parallelism of 10, instead of the 2 kept by http.DefaultTransport
+const maxIdleConnsPerHost = 16
+
+// Config describes how the provider connects to the OpenWebUI API
+type Config struct {
+	// Timeout limits a request including its retries
+	Timeout time.Duration
+	// MaxRetries and RetryWait configure the RetryTransport
+	MaxRetries int
+	RetryWait  time.Duration
+	// Headers are added to every request by the HeaderTransport
+	Headers map[string]string
+	// CompressRequests gzips large request bodies with the GzipTransport
+	CompressRequests bool
+
+	// CACertPEM holds additional PEM encoded certificate authorities to trust
+	CACertPEM []byte
+	// InsecureSkipVerify disables the verification of the server certificate
+	InsecureSkipVerify bool
+	// ClientCertPEM and ClientKeyPEM hold the certificate presented for mutual TLS
+	ClientCertPEM []byte
+	ClientKeyPEM  []byte
+	// ProxyURL overrides the proxy taken from the HTTP_PROXY and HTTPS_PROXY
+	// environment variables. Hosts listed in NO_PROXY still bypass it.
+	ProxyURL string
+}
+
+// NewClient returns the HTTP client shared by all client packages. Sharing it
+// means all API calls draw from a single pool of keep-alive connections.
+// Requests go through the CacheTransport, RetryTransport, LoggingTransport,
+// HeaderTransport and, when enabled, the GzipTransport, in that order, so that
+// every attempt is logged with its uncompressed body, including revalidations
+// answered with 304 Not Modified.
+func NewClient(cfg Config) (*http.Client, error) {
+	base, err := New(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var rt http.RoundTripper = base
+	if cfg.CompressRequests {
+		rt = &GzipTransport{Base: base}
+	}
+
+	return &http.Client{
+		Timeout: cfg.Timeout,
+		Transport: &CacheTransport{
+			Base: &RetryTransport{
+				Base: &LoggingTransport{
+					Base: &HeaderTransport{
+						Base:    rt,
+						Headers: cfg.Headers,
+					},
+				},
+				MaxRetries: cfg.MaxRetries,
+				RetryWait:  cfg.RetryWait,
+			},
+		},
+	}, nil
+}
+
+// New returns a transport configured from cfg. It starts from a clone of
+// http.DefaultTransport so that the standard dial and idle settings are kept.
+func New(cfg Config) (*http.Transport, error) {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.MaxIdleConnsPerHost = maxIdleConnsPerHost
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+	}
+
+	if len(cfg.CACertPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(cfg.CACertPEM) {
+			return nil, errors.New("no valid PEM encoded certificates found in the CA bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if len(cfg.ClientCertPEM) > 0 || len(cfg.ClientKeyPEM) > 0 {
+		cert, err := tls.X509KeyPair(cfg.ClientCertPEM, cfg.ClientKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("error loading client certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	t.TLSClientConfig = tlsConfig
+
+	proxyConfig := httpproxy.FromEnvironment()
+	if cfg.ProxyURL != "" {
+		if _, err := url.Parse(cfg.ProxyURL); err != nil {
+			return nil, fmt.Errorf("error parsing proxy URL: %v", err)
+		}
+		proxyConfig.HTTPProxy = cfg.ProxyURL
+		proxyConfig.HTTPSProxy = cfg.ProxyURL
+	}
+	proxyFunc := proxyConfig.ProxyFunc()
+	t.Proxy = func(req *http.Request) (*url.URL, error) {
+		return proxyFunc(req.URL)
+	}
+
+	return t, nil
+}
@@ -0,0 +1,213 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package transport
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewTrustsCACertPEM(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+
+	// The test server certificate is not trusted by default
+	tr, err := New(Config{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := (&http.Client{Transport: tr}).Get(ts.URL); err == nil {
+		t.Fatal("expected a certificate error without a CA bundle")
+	}
+
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	tr, err = New(Config{CACertPEM: caPEM})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp, err := (&http.Client{Transport: tr}).Get(ts.URL)
+	if err != nil {
+		t.Fatalf("unexpected error with CA bundle: %v", err)
+	}
+	resp.Body.Close()
+}
+
+func TestNewInsecureSkipVerify(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+
+	tr, err := New(Config{InsecureSkipVerify: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp, err := (&http.Client{Transport: tr}).Get(ts.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+}
+
+func TestNewRejectsInvalidCACertPEM(t *testing.T) {
+	if _, err := New(Config{CACertPEM: []byte("not a certificate")}); err == nil {
+		t.Fatal("expected an error for an invalid CA bundle")
+	}
+}
+
+func TestNewPresentsClientCertificate(t *testing.T) {
+	certPEM, keyPEM := selfSignedCertificate(t)
+
+	var presented int
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		presented = len(r.TLS.PeerCertificates)
+	}))
+	ts.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	ts.StartTLS()
+	defer ts.Close()
+
+	tr, err := New(Config{InsecureSkipVerify: true, ClientCertPEM: certPEM, ClientKeyPEM: keyPEM})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp, err := (&http.Client{Transport: tr}).Get(ts.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if presented != 1 {
+		t.Errorf("presented certificates = %d, want 1", presented)
+	}
+}
+
+func TestNewRejectsMismatchedClientKey(t *testing.T) {
+	certPEM, _ := selfSignedCertificate(t)
+	_, keyPEM := selfSignedCertificate(t)
+
+	if _, err := New(Config{ClientCertPEM: certPEM, ClientKeyPEM: keyPEM}); err == nil {
+		t.Fatal("expected an error for a key that does not match the certificate")
+	}
+}
+
+func selfSignedCertificate(t *testing.T) ([]byte, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestNewProxyURL(t *testing.T) {
+	t.Setenv("HTTPS_PROXY", "http://env-proxy:3128")
+	t.Setenv("NO_PROXY", "internal.example")
+
+	tests := []struct {
+		name     string
+		proxyURL string
+		target   string
+		want     string
+	}{
+		{name: "environment", target: "https://openwebui.example", want: "http://env-proxy:3128"},
+		{name: "override", proxyURL: "http://proxy:8080", target: "https://openwebui.example", want: "http://proxy:8080"},
+		{name: "no proxy", proxyURL: "http://proxy:8080", target: "https://internal.example", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr, err := New(Config{ProxyURL: tt.proxyURL})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			req, _ := http.NewRequest("GET", tt.target, nil)
+			got, err := tr.Proxy(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got == nil && tt.want != "" || got != nil && got.String() != tt.want {
+				t.Errorf("proxy = %v, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewClientReusesConnections(t *testing.T) {
+	var connections atomic.Int32
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(10 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{"id": "m"})
+	}))
+	ts.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			connections.Add(1)
+		}
+	}
+	ts.Start()
+	defer ts.Close()
+
+	client, err := NewClient(Config{Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Rounds of concurrent requests, like an apply with the default parallelism
+	const parallelism = 10
+	for round := 0; round < 5; round++ {
+		var wg sync.WaitGroup
+		for i := 0; i < parallelism; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				req, _ := http.NewRequestWithContext(context.Background(), "GET", ts.URL, nil)
+				resp, err := client.Do(req)
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+				_, _ = io.Copy(io.Discard, resp.Body)
+				resp.Body.Close()
+			}()
+		}
+		wg.Wait()
+	}
+
+	if got := connections.Load(); got > parallelism {
+		t.Errorf("connections = %d, want at most %d", got, parallelism)
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package users
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/apierror"
+	"github.com/coalition-sre/terraform-provider-openwebui/pkg/openwebui/internal/rest"
+)
+
+// Client implements the users operations
+type Client struct {
+	api *rest.Client
+}
+
+// NewClient creates a new users client
+func NewClient(endpoint, token string, httpClient *http.Client) *Client {
+	return &Client{api: rest.New(endpoint, token, httpClient)}
+}
+
+// pageSize is the number of users requested per page. Servers that paginate
+// by page number use their own fixed page size and ignore it.
+const pageSize = 50
+
+// GetUsers retrieves all users, following pagination
+func (c *Client) GetUsers(ctx context.Context) ([]User, error) {
+	list, err := c.ListUsers(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	return list.Users, nil
+}
+
+// ListUsers retrieves all users whose name or email contains query, following pagination
+func (c *Client) ListUsers(ctx context.Context, query string) (*UserList, error) {
+	list := &UserList{}
+	seen := map[string]bool{}
+
+	for page := 1; ; page++ {
+		result, err := c.ListUsersPage(ctx, query, page)
+		if err != nil {
+			return nil, err
+		}
+
+		// Servers that ignore the paging parameters return the same users
+		// for every page, so a page without new users is the last one
+		added := 0
+		for _, user := range result.Users {
+			if id := user.ID.ValueString(); !seen[id] {
+				seen[id] = true
+				list.Users = append(list.Users, user)
+				added++
+			}
+		}
+		list.Total = result.Total
+
+		// Older servers return a plain list without a total, in which case a
+		// short page is the last one
+		if added == 0 ||
+			(result.Total < 0 && len(result.Users) < pageSize) ||
+			(result.Total >= 0 && len(list.Users) >= result.Total) {
+			break
+		}
+	}
+
+	if list.Total < 0 {
+		list.Total = len(list.Users)
+	}
+	return list, nil
+}
+
+// ListUsersPage retrieves a single page of users whose name or email contains
+// query. Pages are numbered from 1. Total is -1 when the server does not report it.
+func (c *Client) ListUsersPage(ctx context.Context, query string, page int) (*UserList, error) {
+	// Newer servers paginate by page number, older ones by skip and limit
+	params := url.Values{}
+	params.Set("page", strconv.Itoa(page))
+	params.Set("skip", strconv.Itoa((page-1)*pageSize))
+	params.Set("limit", strconv.Itoa(pageSize))
+	if query != "" {
+		params.Set("query", query)
+	}
+
+	var raw json.RawMessage
+	if err := c.api.Do(ctx, "GET", "/api/v1/users/?"+params.Encode(), nil, &raw); err != nil {
+		return nil, err
+	}
+
+	var err error
+	apiUserList := APIUserList{Total: -1}
+	if bytes.HasPrefix(bytes.TrimSpace(raw), []byte("[")) {
+		err = json.Unmarshal(raw, &apiUserList.Users)
+	} else {
+		err = json.Unmarshal(raw, &apiUserList)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error decoding response: %v", err)
+	}
+
+	list := &UserList{Users: make([]User, 0, len(apiUserList.Users)), Total: apiUserList.Total}
+	for _, apiUser := range apiUserList.Users {
+		list.Users = append(list.Users, *APIToUser(&apiUser))
+	}
+
+	return list, nil
+}
+
+// GetUser retrieves a single user by ID
+func (c *Client) GetUser(ctx context.Context, id string) (*User, error) {
+	// First get all users
+	users, err := c.GetUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find user by ID
+	for _, user := range users {
+		if user.ID.ValueString() == id {
+			return &user, nil
+		}
+	}
+
+	return nil, fmt.Errorf("user %s: %w", id, apierror.ErrNotFound)
+}
+
+// FindUserByEmail finds a user by their email address
+func (c *Client) FindUserByEmail(ctx context.Context, email string) (*User, error) {
+	list, err := c.ListUsers(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+
+	// OpenWebUI stores emails in lowercase, so compare case-insensitively
+	for _, user := range list.Users {
+		if strings.EqualFold(user.Email.ValueString(), email) {
+			return &user, nil
+		}
+	}
+
+	return nil, fmt.Errorf("user not found with email: %s", email)
+}
+
+// FindUserByName finds a user by their name
+func (c *Client) FindUserByName(ctx context.Context, name string) (*User, error) {
+	users, err := c.GetUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range users {
+		if user.Name.ValueString() == name {
+			return &user, nil
+		}
+	}
+
+	return nil, fmt.Errorf("user not found with name: %s", name)
+}
+
+// AddUser creates a new user through the admin add-user endpoint
+func (c *Client) AddUser(ctx context.Context, form *APIAddUserForm) (*User, error) {
+	// The response is a sign-in response for the new user, which includes a session token
+	var created struct {
+		ID string `json:"id"`
+	}
+	if err := c.api.Do(ctx, "POST", "/api/v1/auths/add", form, &created); err != nil {
+		return nil, err
+	}
+
+	return c.GetUser(ctx, created.ID)
+}
+
+// UpdateUser updates the name, email, profile image and optionally the password of a user
+func (c *Client) UpdateUser(ctx context.Context, id string, form *APIUserUpdateForm) (*User, error) {
+	var apiUser APIUser
+	if err := c.api.Do(ctx, "POST", fmt.Sprintf("/api/v1/users/%s/update", id), form, &apiUser); err != nil {
+		return nil, err
+	}
+
+	return APIToUser(&apiUser), nil
+}
+
+// UpdateUserRole changes the role of a user
+func (c *Client) UpdateUserRole(ctx context.Context, id string, role string) (*User, error) {
+	var apiUser APIUser
+	if err := c.api.Do(ctx, "POST", "/api/v1/users/update/role", &APIUserRoleUpdateForm{ID: id, Role: role}, &apiUser); err != nil {
+		return nil, err
+	}
+
+	return APIToUser(&apiUser), nil
+}
+
+// DeleteUser deletes a user
+func (c *Client) DeleteUser(ctx context.Context, id string) error {
+	return c.api.Do(ctx, "DELETE", fmt.Sprintf("/api/v1/users/%s", id), nil, nil)
+}
@@ -0,0 +1,125 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package users
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func testUsers(n int) []APIUser {
+	users := make([]APIUser, n)
+	for i := range users {
+		users[i] = APIUser{ID: fmt.Sprintf("u%d", i), Name: fmt.Sprintf("User %d", i), Email: fmt.Sprintf("user%d@example.com", i), Role: "user"}
+	}
+	return users
+}
+
+func TestListUsersFollowsPages(t *testing.T) {
+	// Newer servers paginate by page number with a fixed page size of their own
+	all := testUsers(75)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		start := min((page-1)*30, len(all))
+		end := min(start+30, len(all))
+		_ = json.NewEncoder(w).Encode(APIUserList{Users: all[start:end], Total: len(all)})
+	}))
+	defer ts.Close()
+
+	client := NewClient(ts.URL, "token", ts.Client())
+	list, err := client.ListUsers(context.Background(), "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Users) != 75 || list.Total != 75 {
+		t.Fatalf("got %d users with total %d, want 75 and 75", len(list.Users), list.Total)
+	}
+
+	user, err := client.FindUserByEmail(context.Background(), "USER74@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user.ID.ValueString() != "u74" {
+		t.Errorf("ID = %q, want %q", user.ID.ValueString(), "u74")
+	}
+}
+
+func TestListUsersFollowsSkipAndLimit(t *testing.T) {
+	// Older servers paginate by skip and limit and return a plain list
+	all := testUsers(120)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		skip, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		start := min(skip, len(all))
+		end := min(start+limit, len(all))
+		_ = json.NewEncoder(w).Encode(all[start:end])
+	}))
+	defer ts.Close()
+
+	list, err := NewClient(ts.URL, "token", ts.Client()).ListUsers(context.Background(), "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Users) != 120 || list.Total != 120 {
+		t.Fatalf("got %d users with total %d, want 120 and 120", len(list.Users), list.Total)
+	}
+	if list.Users[119].ID.ValueString() != "u119" {
+		t.Errorf("last ID = %q, want %q", list.Users[119].ID.ValueString(), "u119")
+	}
+}
+
+func TestListUsersStopsWhenPagingIsIgnored(t *testing.T) {
+	// A server that ignores the paging parameters returns all users every time
+	all := testUsers(60)
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_ = json.NewEncoder(w).Encode(all)
+	}))
+	defer ts.Close()
+
+	list, err := NewClient(ts.URL, "token", ts.Client()).ListUsers(context.Background(), "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Users) != 60 || list.Total != 60 {
+		t.Fatalf("got %d users with total %d, want 60 and 60", len(list.Users), list.Total)
+	}
+	if requests != 2 {
+		t.Errorf("requests = %d, want 2", requests)
+	}
+}
+
+func TestUpdateDefaultPermissionsKeepsOtherPermissions(t *testing.T) {
+	stored := Permissions{
+		"workspace": {"models": false, "knowledge": false},
+		"chat":      {"delete": true, "share": true},
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			stored = Permissions{}
+			_ = json.NewDecoder(r.Body).Decode(&stored)
+		}
+		_ = json.NewEncoder(w).Encode(stored)
+	}))
+	defer ts.Close()
+
+	client := NewClient(ts.URL, "token", ts.Client())
+	result, err := client.UpdateDefaultPermissions(context.Background(), Permissions{
+		"chat":     {"delete": false},
+		"features": {"web_search": true},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result["chat"]["delete"] || !result["chat"]["share"] || !result["features"]["web_search"] || len(result["workspace"]) != 2 {
+		t.Errorf("permissions = %v, want the changes merged into the current permissions", result)
+	}
+}
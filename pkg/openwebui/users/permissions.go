@@ -0,0 +1,48 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package users
+
+import (
+	"context"
+)
+
+// Permissions holds permission flags by section (workspace, chat, sharing,
+// features) and name, e.g. Permissions["chat"]["delete"]
+type Permissions map[string]map[string]bool
+
+// GetDefaultPermissions gets the default permissions of users with the user
+// role. Group permissions are added on top of them.
+func (c *Client) GetDefaultPermissions(ctx context.Context) (Permissions, error) {
+	var result Permissions
+	if err := c.api.Do(ctx, "GET", "/api/v1/users/default/permissions", nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateDefaultPermissions changes the default permissions of users with the
+// user role. Permissions that are not in changes keep their current value.
+func (c *Client) UpdateDefaultPermissions(ctx context.Context, changes Permissions) (Permissions, error) {
+	permissions, err := c.GetDefaultPermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if permissions == nil {
+		permissions = make(Permissions, len(changes))
+	}
+	for section, flags := range changes {
+		if permissions[section] == nil {
+			permissions[section] = make(map[string]bool, len(flags))
+		}
+		for name, value := range flags {
+			permissions[section][name] = value
+		}
+	}
+
+	var result Permissions
+	if err := c.api.Do(ctx, "POST", "/api/v1/users/default/permissions", permissions, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,56 @@
+// Copyright (c) Coalition, Inc
+// SPDX-License-Identifier: MIT
+
+package users
+
+import (
+	"context"
+)
+
+// Settings holds the settings of the authenticated user. The interface
+// settings are under the "ui" key, e.g. the default models under ui.models
+// and the system prompt under ui.system.
+type Settings map[string]interface{}
+
+// UI returns the interface settings, or nil when none were saved
+func (s Settings) UI() map[string]interface{} {
+	ui, _ := s["ui"].(map[string]interface{})
+	return ui
+}
+
+// GetSettings gets the settings of the authenticated user. The result is nil
+// when the user never saved settings.
+func (c *Client) GetSettings(ctx context.Context) (Settings, error) {
+	var result Settings
+	if err := c.api.Do(ctx, "GET", "/api/v1/users/user/settings", nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateUISettings changes the interface settings of the authenticated user.
+// Settings that are not in changes keep their current value.
+func (c *Client) UpdateUISettings(ctx context.Context, changes map[string]interface{}) (Settings, error) {
+	settings, err := c.GetSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if settings == nil {
+		settings = Settings{}
+	}
+
+	ui := settings.UI()
+	if ui == nil {
+		ui = make(map[string]interface{}, len(changes))
+	}
+	for key, value := range changes {
+		ui[key] = value
+	}
+	settings["ui"] = ui
+
+	var result Settings
+	if err := c.api.Do(ctx, "POST", "/api/v1/users/user/settings/update", settings, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -4,6 +4,9 @@
 package users
 
 import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -22,6 +25,14 @@ type User struct {
 	OAuthSub        types.String `tfsdk:"oauth_sub"`
 }
 
+// UserList is a list of users together with the number of users the server
+// reported in total
+type UserList struct {
+	Users []User
+	Total int
+}
+
+// APIUserList represents the paginated API response model for users
 type APIUserList struct {
 	Users []APIUser `json:"users"`
 	Total int       `json:"total"`
@@ -42,6 +53,29 @@ type APIUser struct {
 	OAuthSub        string                 `json:"oauth_sub"`
 }
 
+// APIAddUserForm represents the admin request for adding a user
+type APIAddUserForm struct {
+	Name            string `json:"name"`
+	Email           string `json:"email"`
+	Password        string `json:"password"`
+	ProfileImageURL string `json:"profile_image_url,omitempty"`
+	Role            string `json:"role,omitempty"`
+}
+
+// APIUserUpdateForm represents the admin request for updating a user
+type APIUserUpdateForm struct {
+	Name            string  `json:"name"`
+	Email           string  `json:"email"`
+	ProfileImageURL string  `json:"profile_image_url"`
+	Password        *string `json:"password,omitempty"`
+}
+
+// APIUserRoleUpdateForm represents the admin request for changing the role of a user
+type APIUserRoleUpdateForm struct {
+	ID   string `json:"id"`
+	Role string `json:"role"`
+}
+
 // APISettings represents the API response model for user settings
 type APISettings struct {
 	UI map[string]any `json:"ui,omitempty"`
@@ -65,9 +99,22 @@ func APIToUser(apiUser *APIUser) *User {
 		user.APIKey = types.StringValue(*apiUser.APIKey)
 	}
 
+	user.Info = types.MapNull(types.StringType)
 	if apiUser.Info != nil {
-		info, _ := types.MapValueFrom(nil, types.StringType, apiUser.Info)
-		user.Info = info
+		// Info is free-form JSON; keep strings as-is and encode everything else
+		info := make(map[string]attr.Value, len(apiUser.Info))
+		for k, v := range apiUser.Info {
+			if str, ok := v.(string); ok {
+				info[k] = types.StringValue(str)
+				continue
+			}
+			encoded, err := json.Marshal(v)
+			if err != nil {
+				continue
+			}
+			info[k] = types.StringValue(string(encoded))
+		}
+		user.Info = types.MapValueMust(types.StringType, info)
 	}
 
 	return user